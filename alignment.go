@@ -0,0 +1,61 @@
+package tablewriter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidAlignment is returned when an alignment name is not recognized.
+var ErrInvalidAlignment = errors.New("tablewriter: invalid alignment")
+
+// alignmentNames maps each Alignment to its name.
+var alignmentNames = map[Alignment]string{
+	AlignLeft:   "left",
+	AlignCenter: "center",
+	AlignRight:  "right",
+}
+
+// String returns the name of a, e.g. "right".
+func (a Alignment) String() string {
+	if name, ok := alignmentNames[a]; ok {
+		return name
+	}
+	return fmt.Sprintf("Alignment(%d)", int(a))
+}
+
+// ParseAlignment returns the Alignment named s ("left", "center", or
+// "right"), ignoring case and surrounding spaces.
+//
+// Example:
+//
+//	a, err := tablewriter.ParseAlignment("right") // tablewriter.AlignRight
+func ParseAlignment(s string) (Alignment, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	for a, n := range alignmentNames {
+		if n == name {
+			return a, nil
+		}
+	}
+	return AlignLeft, fmt.Errorf("%w: %q (want left, center, or right)", ErrInvalidAlignment, s)
+}
+
+// MarshalText encodes a by name, implementing encoding.TextMarshaler.
+func (a Alignment) MarshalText() ([]byte, error) {
+	name, ok := alignmentNames[a]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidAlignment, int(a))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText parses text with ParseAlignment, implementing
+// encoding.TextUnmarshaler.
+func (a *Alignment) UnmarshalText(text []byte) error {
+	v, err := ParseAlignment(string(text))
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
@@ -0,0 +1,178 @@
+package tablewriter_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestOptionsJSONRoundTrip(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers:        []string{"Name", "Size"},
+		Format:         tablewriter.FormatMarkdown,
+		Alignments:     []tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignRight},
+		MaxColumnWidth: 20,
+		Formatters:     []tablewriter.Formatter{nil, tablewriter.BytesFormatter(true)},
+	}
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	for _, want := range []string{`"Format":"markdown"`, `"Alignments":["left","right"]`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Marshal() = %s, want it to contain %s", data, want)
+		}
+	}
+
+	var got tablewriter.Options
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	opts.Formatters = nil
+	if !reflect.DeepEqual(got, opts) {
+		t.Errorf("Unmarshal() = %+v, want %+v", got, opts)
+	}
+}
+
+func TestParseAlignment(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    tablewriter.Alignment
+		wantErr error
+	}{
+		{"right", tablewriter.AlignRight, nil},
+		{" Center", tablewriter.AlignCenter, nil},
+		{"justify", tablewriter.AlignLeft, tablewriter.ErrInvalidAlignment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := tablewriter.ParseAlignment(tt.in)
+			if !errors.Is(err, tt.wantErr) || got != tt.want {
+				t.Errorf("ParseAlignment(%q) = %v, %v, want %v, %v", tt.in, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOptionsJSONRoundTripAll(t *testing.T) {
+	opts := tablewriter.Options{
+		Columns:                     []tablewriter.Column{{Name: "ID", Alignment: tablewriter.AlignRight, Type: tablewriter.TypeInt, Unit: "n"}},
+		Headers:                     []string{"Name", "Size"},
+		HeaderLabels:                map[string]string{"Name": "Host"},
+		Units:                       []string{"", "MB"},
+		NoHeader:                    true,
+		Format:                      tablewriter.FormatHTML,
+		Alignments:                  []tablewriter.Alignment{tablewriter.AlignCenter},
+		ColumnTypes:                 []tablewriter.ColumnType{tablewriter.TypeString, tablewriter.TypeFloat},
+		MaxColumnWidth:              20,
+		WrapCells:                   true,
+		WrapHeaders:                 true,
+		Hyphenate:                   true,
+		TruncateUnit:                tablewriter.TruncateDisplayWidth,
+		MaxColumnWidths:             []int{0, 8},
+		MaxTableWidth:               80,
+		WidthPercentile:             90,
+		MinColumnWidths:             []int{3},
+		ColumnPercents:              []int{40, 60},
+		HeaderMaxWidth:              12,
+		ExemptHeadersFromTruncation: true,
+		MaxOutputBytes:              1 << 20,
+		Workers:                     4,
+		StyleRules:                  map[string][]tablewriter.StyleRule{"Size": {{When: "> 100", Color: "red", Class: "big"}}},
+		NullPlaceholder:             "-",
+		NullPlaceholders:            []string{"", "n/a"},
+		StrictColumnCount:           true,
+		RaggedRows:                  tablewriter.PadShortRows | tablewriter.TruncateLongRows,
+		ExplicitNulls:               true,
+		CollapseRepeats:             []bool{true},
+		OmitEmptyColumns:            true,
+		WideColumns:                 []string{"Size"},
+		Wide:                        true,
+		ColumnFilter:                []string{"!Size"},
+		EscapeFormulas:              true,
+		DuplicateHeaders:            tablewriter.DuplicateError,
+		NestedJSON:                  true,
+		Schema:                      &tablewriter.Schema{Type: tablewriter.SchemaType{"object"}, Required: []string{"Name"}},
+		SanitizeUTF8:                true,
+		InvalidUTF8Marker:           "?",
+		EmptyMessage:                "(none)",
+		SplitWidth:                  100,
+		SplitAnchors:                []bool{true},
+		ResponsiveWidth:             60,
+		ColumnPriorities:            []int{2, 1},
+		Footnotes:                   []tablewriter.Footnote{{Row: tablewriter.HeaderRow, Column: 1, Text: "in MB"}},
+		Footers:                     [][]string{{"Total", "42"}},
+		FooterSeparator:             tablewriter.FooterDoubleRule,
+		SortBy:                      "Name",
+		SortMode:                    tablewriter.SortNatural | tablewriter.SortFoldCase,
+		SortKeys:                    []tablewriter.SortKey{{Column: "Size", Descending: true, Mode: tablewriter.SortCollate}},
+		NoColor:                     true,
+		CSVDialect:                  tablewriter.CSVSheetsTSV,
+		CSVDelimiter:                ';',
+		CSVNull:                     `\N`,
+		CSVQuoting:                  tablewriter.CSVQuoteNonNumeric,
+		CSVEscape:                   '\\',
+		Charset:                     tablewriter.CharsetWindows1252,
+		CharsetReplacement:          "?",
+		UniqueKey:                   "Name",
+		OnDuplicateKey:              tablewriter.DuplicateKeyOverwrite,
+		Offset:                      1,
+		Limit:                       10,
+		MinRows:                     3,
+		CacheWidths:                 true,
+		CompactRows:                 true,
+		HTMLPage:                    true,
+		HTMLTitle:                   "Report",
+		MarkdownLoose:               true,
+		MarkdownCompactSeparator:    true,
+	}
+	v := reflect.ValueOf(opts)
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if f.IsExported() && f.Tag.Get("json") != "-" && v.Field(i).IsZero() {
+			t.Errorf("field %s is not set; add it so the round trip covers it", f.Name)
+		}
+	}
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	for _, want := range []string{
+		`"TruncateUnit":"display-width"`,
+		`"RaggedRows":"pad|truncate"`,
+		`"DuplicateHeaders":"error"`,
+		`"FooterSeparator":"double-rule"`,
+		`"SortMode":"natural|fold-case"`,
+		`"Mode":"collate"`,
+		`"CSVDialect":"sheets-tsv"`,
+		`"CSVQuoting":"non-numeric"`,
+		`"Charset":"windows-1252"`,
+		`"OnDuplicateKey":"overwrite"`,
+		`"ColumnTypes":["string","float"]`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Marshal() = %s, want it to contain %s", data, want)
+		}
+	}
+	var got tablewriter.Options
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, opts) {
+		t.Errorf("Unmarshal() = %+v, want %+v", got, opts)
+	}
+}
+
+func TestOptionsJSONInvalidEnum(t *testing.T) {
+	for _, data := range []string{`{"CSVDialect":"lotus"}`, `{"SortMode":"natural|random"}`, `{"Charset":"ebcdic"}`} {
+		var opts tablewriter.Options
+		if err := json.Unmarshal([]byte(data), &opts); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+			t.Errorf("Unmarshal(%s) error = %v, want %v", data, err, tablewriter.ErrInvalidOptions)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package tablewriter
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrAppendUnsupported is returned by RenderAppend for formats whose output
+// cannot be extended line by line, such as FormatJSON, and for tables with
+// MaxOutputBytes set, whose cap applies to a single render.
+var ErrAppendUnsupported = errors.New("tablewriter: format does not support appending")
+
+// RenderAppend renders only the rows added since the previous call, so a
+// tailing command can print new table lines without reprinting the table.
+// The first call renders the headers and every row, and fixes the column
+// widths; later calls lay out new rows in those widths, truncating cells that
+// no longer fit. FormatPlain output omits the bottom border so that lines can
+// follow it. Reset starts over. Rows changed with SetRow after they were
+// rendered are not reprinted, and sorting applies within each batch.
+//
+// Example:
+//
+//	for ev := range events {
+//	    t.AddRow(ev.Time, ev.Message)
+//	    out, err := t.RenderAppend()
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Print(out)
+//	}
+func (t *Table) RenderAppend() (string, error) {
+	if t.opts.Format == FormatJSON || t.opts.MaxOutputBytes > 0 {
+		return "", ErrAppendUnsupported
+	}
+	if t.appendWidths == nil {
+		return t.renderAppendFirst()
+	}
+	if t.appended >= len(t.rows) {
+		return "", nil
+	}
+	opts, rows, err := prepare(t.opts, t.rows[t.appended:], t.meta[t.appended:])
+	if err != nil {
+		return "", err
+	}
+	t.appended = len(t.rows)
+	if !isTextFormat(opts.Format) {
+		opts.Headers = nil
+		return render(opts, rows)
+	}
+
+	var b strings.Builder
+	for _, r := range rows {
+		cells := make([]string, len(r))
+		for i, c := range r {
+			c, err = applyCellOpts(c, opts)
+			if err != nil {
+				return "", err
+			}
+			if i < len(t.appendWidths) {
+				c = truncate(c, t.appendWidths[i], TruncateDisplayWidth)
+			}
+			cells[i] = c
+		}
+		b.WriteString(formatLine(opts.Format, cells, t.appendWidths, t.appendAligns, opts) + "\n")
+	}
+	return b.String(), nil
+}
+
+// renderAppendFirst renders the whole table and records its column widths
+// for later RenderAppend calls.
+func (t *Table) renderAppendFirst() (string, error) {
+	widths, aligns, err := t.ColumnWidths()
+	if err != nil {
+		return "", err
+	}
+	out, err := t.RenderErr()
+	if err != nil {
+		return "", err
+	}
+	out = strings.TrimSuffix(out, "\n")
+	if t.opts.Format == FormatPlain {
+		if i := strings.LastIndex(out, "\n"); i >= 0 {
+			out = out[:i] // bottom border
+		}
+	}
+	t.appendWidths, t.appendAligns = widths, aligns
+	if t.appendWidths == nil {
+		t.appendWidths = []int{}
+	}
+	t.appended = len(t.rows)
+	return out + "\n", nil
+}
@@ -0,0 +1,110 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRenderAppend(t *testing.T) {
+	tests := []struct {
+		name   string
+		format tablewriter.Format
+		first  string
+		next   string
+	}{
+		{
+			"markdown",
+			tablewriter.FormatMarkdown,
+			"| Time  | Message |\n| ----- | ------- |\n| 10:00 | started |\n",
+			"| 10:01 | stop... |\n| 10:02 | ok      |\n",
+		},
+		{
+			"csv",
+			tablewriter.FormatCSV,
+			"Time,Message\n10:00,started\n",
+			"10:01,stopped early\n10:02,ok\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Time", "Message"}, Format: tt.format})
+			tbl.AddRow("10:00", "started")
+			out, err := tbl.RenderAppend()
+			if err != nil {
+				t.Fatalf("RenderAppend() error = %v", err)
+			}
+			if out != tt.first {
+				t.Errorf("first RenderAppend() = %q, want %q", out, tt.first)
+			}
+
+			tbl.AddRow("10:01", "stopped early")
+			tbl.AddRow("10:02", "ok")
+			if out, err = tbl.RenderAppend(); err != nil || out != tt.next {
+				t.Errorf("second RenderAppend() = %q, %v, want %q", out, err, tt.next)
+			}
+			if out, err = tbl.RenderAppend(); err != nil || out != "" {
+				t.Errorf("third RenderAppend() = %q, %v, want empty", out, err)
+			}
+		})
+	}
+}
+
+func TestRenderAppendUnsupported(t *testing.T) {
+	tests := []struct {
+		name string
+		opts tablewriter.Options
+	}{
+		{"json", tablewriter.Options{Format: tablewriter.FormatJSON}},
+		{"max output bytes", tablewriter.Options{Format: tablewriter.FormatPlain, MaxOutputBytes: 64}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			opts.Headers = []string{"a"}
+			tbl := tablewriter.New(opts)
+			tbl.AddRow("1")
+			if _, err := tbl.RenderAppend(); !errors.Is(err, tablewriter.ErrAppendUnsupported) {
+				t.Errorf("RenderAppend() error = %v, want %v", err, tablewriter.ErrAppendUnsupported)
+			}
+		})
+	}
+}
+
+func TestRenderAppendPadding(t *testing.T) {
+	for _, width := range []int{5, 256, 300} {
+		header := strings.Repeat("h", width)
+		tbl := tablewriter.New(tablewriter.Options{
+			Headers:    []string{header, "B"},
+			Format:     tablewriter.FormatMarkdown,
+			Alignments: []tablewriter.Alignment{tablewriter.AlignRight, tablewriter.AlignCenter},
+		})
+		if _, err := tbl.RenderAppend(); err != nil {
+			t.Fatalf("RenderAppend() error = %v", err)
+		}
+		tbl.AddRow("x", "")
+		want := "| " + strings.Repeat(" ", width-1) + "x |       |\n"
+		if out, err := tbl.RenderAppend(); err != nil || out != want {
+			t.Errorf("width %d: RenderAppend() = %q, %v, want %q", width, out, err, want)
+		}
+	}
+}
+
+func TestRenderAppendMarkdownEscape(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Expr"}, Format: tablewriter.FormatMarkdown})
+	tbl.AddRow("x|y")
+	first, err := tbl.RenderAppend()
+	if err != nil {
+		t.Fatalf("RenderAppend() error = %v", err)
+	}
+	tbl.AddRow("a|b")
+	next, err := tbl.RenderAppend()
+	if err != nil {
+		t.Fatalf("RenderAppend() error = %v", err)
+	}
+	if want := "| Expr |\n| ---- |\n| x\\|y |\n| a\\|b |\n"; first+next != want {
+		t.Errorf("RenderAppend() = %q, want %q", first+next, want)
+	}
+}
@@ -0,0 +1,82 @@
+package tablewriter
+
+import (
+	"strings"
+	"unsafe"
+)
+
+const (
+	// arenaChunkBytes is the size of each shared buffer cell bytes are
+	// copied into.
+	arenaChunkBytes = 1 << 20
+
+	// arenaSlabCells is the number of cells in each shared slice rows are
+	// cut from.
+	arenaSlabCells = 1 << 14
+)
+
+// rowArena stores rows for a Table with CompactRows set. Cell bytes are
+// appended to large shared buffers and rows are cut from large shared
+// slices, so a million-row table is a few hundred allocations for the
+// garbage collector to track rather than one or more per cell. Buffers are
+// only ever appended to, so the strings pointing into them never change;
+// a full buffer is replaced, not grown.
+type rowArena struct {
+	bytes []byte
+	cells []string
+}
+
+// row returns a copy of cols stored in the arena.
+func (a *rowArena) row(cols []string) []string {
+	if len(a.cells)+len(cols) > cap(a.cells) {
+		a.cells = make([]string, 0, max(arenaSlabCells, len(cols)))
+	}
+	start := len(a.cells)
+	for _, c := range cols {
+		a.cells = append(a.cells, a.copyString(c))
+	}
+	// Cap the row so appending to it cannot overwrite the next row.
+	return a.cells[start:len(a.cells):len(a.cells)]
+}
+
+// copyString returns a copy of s backed by the arena's current buffer.
+// Strings too large to share a buffer are copied on their own.
+func (a *rowArena) copyString(s string) string {
+	if s == "" {
+		return ""
+	}
+	if len(s) > arenaChunkBytes/4 {
+		return strings.Clone(s)
+	}
+	if len(a.bytes)+len(s) > cap(a.bytes) {
+		a.bytes = make([]byte, 0, arenaChunkBytes)
+	}
+	start := len(a.bytes)
+	a.bytes = append(a.bytes, s...)
+	return unsafe.String(&a.bytes[start], len(s))
+}
+
+// newRow returns a copy of cols for storing in the table, from the arena if
+// CompactRows is set.
+func (t *Table) newRow(cols []string) []string {
+	if !t.opts.CompactRows {
+		row := make([]string, len(cols))
+		copy(row, cols)
+		return row
+	}
+	if t.arena == nil {
+		t.arena = &rowArena{}
+	}
+	return t.arena.row(cols)
+}
+
+// WithCompactRows returns a copy of Options that stores a Table's cells in
+// large shared buffers, for tables of millions of rows.
+//
+// Example:
+//
+//	t := tablewriter.New(tablewriter.DefaultOptions().WithCompactRows())
+func (o Options) WithCompactRows() Options {
+	o.CompactRows = true
+	return o
+}
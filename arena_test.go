@@ -0,0 +1,58 @@
+package tablewriter_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestCompactRows(t *testing.T) {
+	opts := tablewriter.Options{Headers: []string{"ID", "Name"}, Format: tablewriter.FormatCSV, CompactRows: true}
+	tbl := tablewriter.New(opts)
+	var want [][]string
+	for i := 0; i < 20000; i++ {
+		row := []string{fmt.Sprint(i), strings.Repeat("n", i%50)}
+		if i == 7 {
+			row[1] = strings.Repeat("big", 200000) // stored on its own
+		}
+		if err := tbl.AddRow(row...); err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, row)
+	}
+	if err := tbl.SetRow(3, "three", ""); err != nil {
+		t.Fatal(err)
+	}
+	want[3] = []string{"three", ""}
+	if got := tbl.Rows(); !reflect.DeepEqual(got, want) {
+		t.Fatal("Rows() differ from the rows added")
+	}
+
+	plain := tablewriter.New(tablewriter.Options{Headers: opts.Headers, Format: tablewriter.FormatCSV})
+	if err := plain.AddRows(want); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tbl.Render(), plain.Render(); got != want {
+		t.Error("Render() with CompactRows differs from default storage")
+	}
+
+	tbl.Reset()
+	tbl.AddRow("a", "b")
+	if got := tbl.Rows(); !reflect.DeepEqual(got, [][]string{{"a", "b"}}) {
+		t.Errorf("Rows() after Reset = %q", got)
+	}
+}
+
+func TestCompactRowsAllocations(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{CompactRows: true})
+	row := []string{"alpha", "beta", "gamma", "delta", "epsilon", "zeta", "eta", "theta"}
+	allocs := testing.AllocsPerRun(10000, func() {
+		tbl.AddRow(row...)
+	})
+	if allocs >= 1 {
+		t.Errorf("AddRow() with CompactRows = %.2f allocations per row, want fewer than 1", allocs)
+	}
+}
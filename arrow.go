@@ -0,0 +1,338 @@
+package tablewriter
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"sort"
+)
+
+// Arrow IPC constants, from the Arrow columnar format specification
+// (Schema.fbs and Message.fbs).
+const (
+	arrowMetadataV5     = 4
+	arrowHeaderSchema   = 1
+	arrowHeaderRecords  = 3
+	arrowTypeInt        = 2
+	arrowTypeFloat      = 3
+	arrowTypeUtf8       = 5
+	arrowTypeBool       = 6
+	arrowPrecisionFloat = 2 // DOUBLE
+	arrowContinuation   = 0xFFFFFFFF
+)
+
+// WriteArrow writes rows to w as an Apache Arrow IPC stream, readable by
+// pyarrow, Polars, and DuckDB, after the same render-time processing as
+// Render. Headers are the field names. Int, Float, and Bool columns,
+// declared in ColumnTypes or inferred from the data, become Int64,
+// Float64, and Bool fields whose empty or unparsable cells are null; all
+// other columns, including times, become Utf8 fields.
+//
+// Example:
+//
+//	f, err := os.Create("report.arrows")
+//	if err != nil {
+//	    return err
+//	}
+//	defer f.Close()
+//	err = tablewriter.WriteArrow(f, opts, rows)
+func WriteArrow(w io.Writer, opts Options, rows [][]string) error {
+	return writeArrow(w, opts, rows, nil)
+}
+
+// WriteArrow writes the table as an Arrow IPC stream; see the package-level
+// WriteArrow.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	err := t.WriteArrow(&buf)
+func (t *Table) WriteArrow(w io.Writer) error {
+	return writeArrow(w, t.opts, t.rows, t.meta)
+}
+
+// writeArrow prepares rows and writes the schema message, one record batch,
+// and the end-of-stream marker.
+func writeArrow(w io.Writer, opts Options, rows [][]string, meta []RowMeta) error {
+	// Field names are keys, as in JSON.
+	opts.Format = FormatJSON
+	opts, rows, err := prepare(opts, rows, meta)
+	if err != nil {
+		return err
+	}
+	if len(opts.Headers) == 0 {
+		return ErrMissingHeaders
+	}
+	types := sqlColumnTypes(opts, rows)
+
+	fields := make([]fbTable, len(opts.Headers))
+	for i, h := range opts.Headers {
+		typeID, typ := arrowType(types[i])
+		fields[i] = fbTable{fbString(h), fbBool(true), fbUint8(typeID), typ, nil, fbTables{}}
+	}
+	schema := fbTable{nil, fbTables(fields)}
+	if err := writeArrowMessage(w, arrowHeaderSchema, schema, nil); err != nil {
+		return err
+	}
+
+	var body []byte
+	var nodes, buffers []byte
+	addBuffer := func(b []byte) {
+		buffers = binary.LittleEndian.AppendUint64(buffers, uint64(len(body)))
+		buffers = binary.LittleEndian.AppendUint64(buffers, uint64(len(b)))
+		body = append(body, b...)
+		for len(body)%8 != 0 {
+			body = append(body, 0)
+		}
+	}
+	for col, t := range types {
+		validity, values, data, nulls := arrowColumn(rows, col, t)
+		nodes = binary.LittleEndian.AppendUint64(nodes, uint64(len(rows)))
+		nodes = binary.LittleEndian.AppendUint64(nodes, uint64(nulls))
+		if nulls == 0 {
+			validity = nil
+		}
+		addBuffer(validity)
+		addBuffer(values)
+		if t == TypeString || t == TypeTime {
+			addBuffer(data)
+		}
+	}
+	batch := fbTable{
+		fbInt64(int64(len(rows))),
+		fbStructs{align: 8, data: nodes, n: len(types)},
+		fbStructs{align: 8, data: buffers, n: len(buffers) / 16},
+	}
+	if err := writeArrowMessage(w, arrowHeaderRecords, batch, body); err != nil {
+		return err
+	}
+	_, err = w.Write([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0})
+	return err
+}
+
+// arrowType returns the Arrow type union tag and type table for t.
+func arrowType(t ColumnType) (uint8, fbTable) {
+	switch t {
+	case TypeInt:
+		return arrowTypeInt, fbTable{fbInt32(64), fbBool(true)}
+	case TypeFloat:
+		return arrowTypeFloat, fbTable{fbInt16(arrowPrecisionFloat)}
+	case TypeBool:
+		return arrowTypeBool, fbTable{}
+	default:
+		return arrowTypeUtf8, fbTable{}
+	}
+}
+
+// arrowColumn encodes column col of rows as Arrow buffers: the validity
+// bitmap, the values (or Utf8 offsets), the Utf8 character data, and the
+// null count.
+func arrowColumn(rows [][]string, col int, t ColumnType) (validity, values, data []byte, nulls int) {
+	validity = make([]byte, (len(rows)+7)/8)
+	if t == TypeBool {
+		values = make([]byte, (len(rows)+7)/8)
+	}
+	if t == TypeString || t == TypeTime {
+		values = binary.LittleEndian.AppendUint32(values, 0)
+	}
+	for i, r := range rows {
+		v := cellAt(r, col)
+		if t == TypeString || t == TypeTime {
+			validity[i/8] |= 1 << (i % 8)
+			data = append(data, v...)
+			values = binary.LittleEndian.AppendUint32(values, uint32(len(data)))
+			continue
+		}
+		pv, ok := t.parse(v)
+		if ok {
+			validity[i/8] |= 1 << (i % 8)
+		} else {
+			nulls++
+		}
+		switch t {
+		case TypeInt:
+			n, _ := pv.(int64)
+			values = binary.LittleEndian.AppendUint64(values, uint64(n))
+		case TypeFloat:
+			f, _ := pv.(float64)
+			values = binary.LittleEndian.AppendUint64(values, math.Float64bits(f))
+		case TypeBool:
+			if b, _ := pv.(bool); b {
+				values[i/8] |= 1 << (i % 8)
+			}
+		}
+	}
+	return validity, values, data, nulls
+}
+
+// writeArrowMessage writes one encapsulated IPC message: the continuation
+// marker, the metadata length, the Message flatbuffer padded to 8 bytes,
+// and the body.
+func writeArrowMessage(w io.Writer, headerType uint8, header fbTable, body []byte) error {
+	msg := fbTable{fbInt16(arrowMetadataV5), fbUint8(headerType), header, fbInt64(int64(len(body)))}
+	meta := buildFlatbuffer(msg)
+	for len(meta)%8 != 0 {
+		meta = append(meta, 0)
+	}
+	prefix := binary.LittleEndian.AppendUint32(nil, arrowContinuation)
+	prefix = binary.LittleEndian.AppendUint32(prefix, uint32(len(meta)))
+	for _, b := range [][]byte{prefix, meta, body} {
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fbTable is a flatbuffers table to serialize, its fields indexed by field
+// id. Nil fields are absent. Field values are scalars (fbScalar), strings
+// (fbString), tables (fbTable), vectors of tables (fbTables), or vectors of
+// structs (fbStructs).
+type fbTable []any
+
+type (
+	fbScalar []byte    // fbScalar is a little-endian scalar field.
+	fbString string    // fbString is a string field.
+	fbTables []fbTable // fbTables is a vector of tables.
+)
+
+// fbStructs is a vector of n fixed-size structs stored in data.
+type fbStructs struct {
+	align int
+	data  []byte
+	n     int
+}
+
+func fbBool(b bool) fbScalar {
+	if b {
+		return fbScalar{1}
+	}
+	return fbScalar{0}
+}
+
+func fbUint8(v uint8) fbScalar { return fbScalar{v} }
+func fbInt16(v int16) fbScalar { return binary.LittleEndian.AppendUint16(nil, uint16(v)) }
+func fbInt32(v int32) fbScalar { return binary.LittleEndian.AppendUint32(nil, uint32(v)) }
+func fbInt64(v int64) fbScalar { return binary.LittleEndian.AppendUint64(nil, uint64(v)) }
+
+// fbBuilder serializes flatbuffers front to back: each table is preceded by
+// its vtable and followed by the objects it refers to, so every offset
+// points forward.
+type fbBuilder struct {
+	buf []byte
+}
+
+// buildFlatbuffer returns the serialized flatbuffer with root table root.
+func buildFlatbuffer(root fbTable) []byte {
+	b := &fbBuilder{buf: make([]byte, 4)}
+	b.patch(0, b.table(root))
+	return b.buf
+}
+
+// pad appends zero bytes until the buffer length is a multiple of align.
+func (b *fbBuilder) pad(align int) {
+	for len(b.buf)%align != 0 {
+		b.buf = append(b.buf, 0)
+	}
+}
+
+// patch writes at pos the unsigned offset from pos to target.
+func (b *fbBuilder) patch(pos, target int) {
+	binary.LittleEndian.PutUint32(b.buf[pos:], uint32(target-pos))
+}
+
+// table writes t and the objects it refers to, returning its position.
+func (b *fbBuilder) table(t fbTable) int {
+	// Lay out fields largest first so each is naturally aligned.
+	ids := make([]int, 0, len(t))
+	for id, v := range t {
+		if v != nil {
+			ids = append(ids, id)
+		}
+	}
+	size := func(v any) int {
+		if s, ok := v.(fbScalar); ok {
+			return len(s)
+		}
+		return 4 // offset
+	}
+	sort.SliceStable(ids, func(i, j int) bool { return size(t[ids[i]]) > size(t[ids[j]]) })
+	offsets := make([]int, len(t))
+	inline, align := 4, 4 // the vtable offset comes first
+	for _, id := range ids {
+		n := size(t[id])
+		for inline%n != 0 {
+			inline++
+		}
+		offsets[id] = inline
+		inline += n
+		align = max(align, n)
+	}
+
+	b.pad(2)
+	vtable := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint16(b.buf, uint16(4+2*len(t)))
+	b.buf = binary.LittleEndian.AppendUint16(b.buf, uint16(inline))
+	for _, off := range offsets {
+		b.buf = binary.LittleEndian.AppendUint16(b.buf, uint16(off))
+	}
+	b.pad(align)
+	pos := len(b.buf)
+	b.buf = append(b.buf, make([]byte, inline)...)
+	binary.LittleEndian.PutUint32(b.buf[pos:], uint32(pos-vtable))
+	for _, id := range ids {
+		if s, ok := t[id].(fbScalar); ok {
+			copy(b.buf[pos+offsets[id]:], s)
+		}
+	}
+	for _, id := range ids {
+		var child int
+		switch v := t[id].(type) {
+		case fbScalar:
+			continue
+		case fbString:
+			child = b.string(string(v))
+		case fbTable:
+			child = b.table(v)
+		case fbTables:
+			child = b.tables(v)
+		case fbStructs:
+			child = b.structs(v)
+		}
+		b.patch(pos+offsets[id], child)
+	}
+	return pos
+}
+
+// string writes a length-prefixed, NUL-terminated string.
+func (b *fbBuilder) string(s string) int {
+	b.pad(4)
+	pos := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(len(s)))
+	b.buf = append(append(b.buf, s...), 0)
+	return pos
+}
+
+// tables writes a vector of offsets followed by the tables they point to.
+func (b *fbBuilder) tables(ts fbTables) int {
+	b.pad(4)
+	pos := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(len(ts)))
+	b.buf = append(b.buf, make([]byte, 4*len(ts))...)
+	for i, t := range ts {
+		b.patch(pos+4+4*i, b.table(t))
+	}
+	return pos
+}
+
+// structs writes a vector of structs, aligning the elements to s.align.
+func (b *fbBuilder) structs(s fbStructs) int {
+	b.pad(4)
+	for (len(b.buf)+4)%s.align != 0 {
+		b.buf = append(b.buf, 0, 0, 0, 0)
+	}
+	pos := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(s.n))
+	b.buf = append(b.buf, s.data...)
+	return pos
+}
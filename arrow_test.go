@@ -0,0 +1,115 @@
+package tablewriter_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+// fbReader reads the parts of a flatbuffer the Arrow tests inspect.
+type fbReader []byte
+
+func (b fbReader) u32(pos int) int { return int(binary.LittleEndian.Uint32(b[pos:])) }
+
+// field returns the position of field id of the table at pos, or -1.
+func (b fbReader) field(table, id int) int {
+	vt := table - int(int32(binary.LittleEndian.Uint32(b[table:])))
+	if 4+2*id >= int(binary.LittleEndian.Uint16(b[vt:])) {
+		return -1
+	}
+	off := int(binary.LittleEndian.Uint16(b[vt+4+2*id:]))
+	if off == 0 {
+		return -1
+	}
+	return table + off
+}
+
+// ref follows the offset stored at pos.
+func (b fbReader) ref(pos int) int { return pos + b.u32(pos) }
+
+func (b fbReader) str(pos int) string {
+	p := b.ref(pos)
+	return string(b[p+4 : p+4+b.u32(p)])
+}
+
+// arrowMessage splits the next encapsulated message off stream, returning
+// its Message flatbuffer and body.
+func arrowMessage(t *testing.T, stream []byte) (fbReader, []byte, []byte) {
+	t.Helper()
+	if binary.LittleEndian.Uint32(stream) != 0xFFFFFFFF {
+		t.Fatalf("missing continuation marker")
+	}
+	n := int(binary.LittleEndian.Uint32(stream[4:]))
+	meta := fbReader(stream[8 : 8+n])
+	msg := meta.u32(0)
+	bodyLen := int(binary.LittleEndian.Uint64(meta[meta.field(msg, 3):]))
+	return meta, stream[8+n : 8+n+bodyLen], stream[8+n+bodyLen:]
+}
+
+func TestWriteArrow(t *testing.T) {
+	opts := tablewriter.Options{Headers: []string{"Name", "Age", "Score", "Active"}}
+	rows := [][]string{{"alice", "30", "1.5", "true"}, {"bob", "", "2", "false"}}
+	var buf bytes.Buffer
+	if err := tablewriter.WriteArrow(&buf, opts, rows); err != nil {
+		t.Fatalf("WriteArrow() error = %v", err)
+	}
+
+	meta, _, rest := arrowMessage(t, buf.Bytes())
+	msg := meta.u32(0)
+	if got := meta[meta.field(msg, 1)]; got != 1 {
+		t.Fatalf("first message header type = %d, want Schema", got)
+	}
+	schema := meta.ref(meta.field(msg, 2))
+	fields := meta.ref(meta.field(schema, 1))
+	var names []string
+	var types []byte
+	for i := 0; i < meta.u32(fields); i++ {
+		f := meta.ref(fields + 4 + 4*i)
+		names = append(names, meta.str(meta.field(f, 0)))
+		types = append(types, meta[meta.field(f, 2)])
+	}
+	if !reflect.DeepEqual(names, opts.Headers) {
+		t.Errorf("field names = %q, want %q", names, opts.Headers)
+	}
+	if want := []byte{5, 2, 3, 6}; !bytes.Equal(types, want) {
+		t.Errorf("field types = %v, want %v (Utf8, Int, FloatingPoint, Bool)", types, want)
+	}
+
+	meta, body, rest := arrowMessage(t, rest)
+	msg = meta.u32(0)
+	if got := meta[meta.field(msg, 1)]; got != 3 {
+		t.Fatalf("second message header type = %d, want RecordBatch", got)
+	}
+	batch := meta.ref(meta.field(msg, 2))
+	if n := binary.LittleEndian.Uint64(meta[meta.field(batch, 0):]); n != 2 {
+		t.Errorf("batch length = %d, want 2", n)
+	}
+	vec := meta.ref(meta.field(batch, 2))
+	buffer := func(i int) []byte {
+		p := vec + 4 + 16*i
+		off := binary.LittleEndian.Uint64(meta[p:])
+		return body[off : off+binary.LittleEndian.Uint64(meta[p+8:])]
+	}
+	if got := string(buffer(2)); got != "alicebob" {
+		t.Errorf("Name data = %q, want %q", got, "alicebob")
+	}
+	if got := buffer(3); !bytes.Equal(got, []byte{0b01}) {
+		t.Errorf("Age validity = %08b, want 00000001", got)
+	}
+	if got := binary.LittleEndian.Uint64(buffer(4)); got != 30 {
+		t.Errorf("Age[0] = %d, want 30", got)
+	}
+	if got := math.Float64frombits(binary.LittleEndian.Uint64(buffer(6)[8:])); got != 2 {
+		t.Errorf("Score[1] = %v, want 2", got)
+	}
+	if got := buffer(8); !bytes.Equal(got, []byte{0b01}) {
+		t.Errorf("Active values = %08b, want 00000001", got)
+	}
+	if !bytes.Equal(rest, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0}) {
+		t.Errorf("stream ends with %x, want end-of-stream marker", rest)
+	}
+}
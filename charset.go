@@ -0,0 +1,114 @@
+package tablewriter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Charset selects the character encoding of rendered output.
+type Charset int
+
+const (
+	CharsetUTF8        Charset = iota // CharsetUTF8 leaves output as UTF-8 (default).
+	CharsetLatin1                     // CharsetLatin1 encodes output as ISO-8859-1.
+	CharsetWindows1252                // CharsetWindows1252 encodes output as Windows-1252, which adds €, smart quotes, and dashes to Latin-1.
+)
+
+// windows1252 maps the runes Windows-1252 places in 0x80-0x9F to their bytes.
+var windows1252 = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87,
+	'ˆ': 0x88, '‰': 0x89, 'Š': 0x8A, '‹': 0x8B, 'Œ': 0x8C, 'Ž': 0x8E,
+	'‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97,
+	'˜': 0x98, '™': 0x99, 'š': 0x9A, '›': 0x9B, 'œ': 0x9C, 'ž': 0x9E, 'Ÿ': 0x9F,
+}
+
+// encode returns s encoded in charset c. Characters c cannot represent
+// become replacement, itself encoded in c, or "?" if replacement is "".
+func (c Charset) encode(s, replacement string) string {
+	if c == CharsetUTF8 {
+		return s
+	}
+	if replacement == "" {
+		replacement = "?"
+	}
+	rep := make([]byte, 0, len(replacement))
+	for _, r := range replacement {
+		bt, ok := c.encodeRune(r)
+		if !ok {
+			bt = '?'
+		}
+		rep = append(rep, bt)
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if bt, ok := c.encodeRune(r); ok {
+			b.WriteByte(bt)
+		} else {
+			b.Write(rep)
+		}
+	}
+	return b.String()
+}
+
+// encodeRune returns the single byte for r in charset c.
+func (c Charset) encodeRune(r rune) (byte, bool) {
+	if c == CharsetWindows1252 {
+		if bt, ok := windows1252[r]; ok {
+			return bt, true
+		}
+		if r >= 0x80 && r <= 0x9F {
+			return 0, false
+		}
+	}
+	if r <= 0xFF {
+		return byte(r), true
+	}
+	return 0, false
+}
+
+// charsetNames maps each Charset to its name in configuration files.
+var charsetNames = map[Charset]string{
+	CharsetUTF8:        "utf-8",
+	CharsetLatin1:      "latin-1",
+	CharsetWindows1252: "windows-1252",
+}
+
+// MarshalText encodes c by name, e.g. "latin-1", implementing
+// encoding.TextMarshaler.
+func (c Charset) MarshalText() ([]byte, error) {
+	return marshalEnum(c, charsetNames, "Charset")
+}
+
+// UnmarshalText parses a name written by MarshalText, ignoring case,
+// implementing encoding.TextUnmarshaler.
+func (c *Charset) UnmarshalText(text []byte) error {
+	v, err := unmarshalEnum(text, charsetNames, "Charset")
+	if err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
+// WithCharset returns a copy of Options that encodes output in c, writing
+// replacement for characters c cannot represent ("" = "?"). Returns
+// ErrInvalidOptions if c is not a known charset or cannot represent
+// replacement itself.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithCharset(tablewriter.CharsetWindows1252, "?")
+func (o Options) WithCharset(c Charset, replacement string) (Options, error) {
+	if _, ok := charsetNames[c]; !ok {
+		return o, fmt.Errorf("invalid charset %d: %w", int(c), ErrInvalidOptions)
+	}
+	for _, r := range replacement {
+		if _, ok := c.encodeRune(r); !ok && c != CharsetUTF8 {
+			return o, fmt.Errorf("charset %s cannot encode replacement %q: %w", charsetNames[c], replacement, ErrInvalidOptions)
+		}
+	}
+	o.Charset = c
+	o.CharsetReplacement = replacement
+	return o, nil
+}
@@ -0,0 +1,64 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestCharset(t *testing.T) {
+	rows := [][]string{{"Café", "5 €", "“ok”", "日本"}}
+	tests := []struct {
+		name    string
+		charset tablewriter.Charset
+		repl    string
+		want    string
+	}{
+		{"utf8", tablewriter.CharsetUTF8, "", "Café,5 €,“ok”,日本\n"},
+		{"latin1", tablewriter.CharsetLatin1, "", "Caf\xe9,5 ?,?ok?,??\n"},
+		{"windows-1252", tablewriter.CharsetWindows1252, "", "Caf\xe9,5 \x80,\x93ok\x94,??\n"},
+		{"custom replacement", tablewriter.CharsetLatin1, "_", "Caf\xe9,5 _,_ok_,__\n"},
+		{"encoded replacement", tablewriter.CharsetLatin1, "¿", "Caf\xe9,5 \xbf,\xbfok\xbf,\xbf\xbf\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Format:             tablewriter.FormatCSV,
+				Charset:            tt.charset,
+				CharsetReplacement: tt.repl,
+			}
+			out, err := tablewriter.Render(opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithCharset(t *testing.T) {
+	opts, err := tablewriter.DefaultOptions().WithCharset(tablewriter.CharsetLatin1, "_")
+	if err != nil {
+		t.Fatalf("WithCharset() error = %v", err)
+	}
+	opts.Format = tablewriter.FormatCSV
+	out, err := tablewriter.Render(opts, [][]string{{"Café €"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "Caf\xe9 _\n"; out != want {
+		t.Errorf("Render() = %q, want %q", out, want)
+	}
+	if _, err := tablewriter.DefaultOptions().WithCharset(tablewriter.Charset(7), ""); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithCharset(7) error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+	if _, err := tablewriter.DefaultOptions().WithCharset(tablewriter.CharsetLatin1, "€"); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithCharset(latin-1, €) error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+	if _, err := tablewriter.DefaultOptions().WithCharset(tablewriter.CharsetWindows1252, "€"); err != nil {
+		t.Errorf("WithCharset(windows-1252, €) error = %v", err)
+	}
+}
@@ -4,44 +4,43 @@ import (
 	"flag"
 	"fmt"
 	"log"
-	"os"
 
-	"njchilds90/go-tablewriter"
+	"github.com/njchilds90/go-tablewriter"
 )
 
 func main() {
 	// Initialize the flag set
 	var (
-		rows int
+		rows    int
 		columns int
 	)
 	flag.IntVar(&rows, "rows", 5, "number of rows")
 	flag.IntVar(&columns, "columns", 3, "number of columns")
 	flag.Parse()
 
-	// Create a new table writer
-	tw := tablewriter.New(os.Stdout)
-
-	// Set the header
+	// Set the header and alignment
 	headers := make([]string, columns)
+	aligns := make([]tablewriter.Alignment, columns)
 	for i := range headers {
 		headers[i] = fmt.Sprintf("Column %d", i+1)
+		aligns[i] = tablewriter.AlignCenter
 	}
-	tw.SetHeader(headers)
-	tw.SetAlignment(tablewriter.CENTER)
+	tw := tablewriter.New(tablewriter.Options{Headers: headers, Alignments: aligns})
 
 	// Generate some data
-	data := make([][]string, rows)
-	for i := range data {
-		data[i] = make([]string, columns)
-		for j := range data[i] {
-			data[i][j] = fmt.Sprintf("Row %d, Column %d", i+1, j+1)
+	for i := 0; i < rows; i++ {
+		row := make([]string, columns)
+		for j := range row {
+			row[j] = fmt.Sprintf("Row %d, Column %d", i+1, j+1)
+		}
+		if err := tw.AddRow(row...); err != nil {
+			log.Fatalf("Failed to add row: %v", err)
 		}
 	}
-	tw.Render(data)
 
-	if err := tw.Render(); err != nil {
-		log.Printf("Failed to render table: %v", err)
-		os.Exit(1)
+	out, err := tw.RenderErr()
+	if err != nil {
+		log.Fatalf("Failed to render table: %v", err)
 	}
+	fmt.Print(out)
 }
@@ -0,0 +1,55 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestCollapseRepeats(t *testing.T) {
+	rows := [][]string{
+		{"EU", "Paris", "10"},
+		{"EU", "Paris", "12"},
+		{"EU", "Berlin", "7"},
+		{"US", "Paris", "3"},
+		{"US", "Paris", "3"},
+	}
+	tests := []struct {
+		name     string
+		format   tablewriter.Format
+		collapse []bool
+		want     string
+	}{
+		{
+			"hierarchical",
+			tablewriter.FormatMarkdown,
+			[]bool{true, true},
+			"| EU | Paris  | 10 |\n|    |        | 12 |\n|    | Berlin | 7  |\n| US | Paris  | 3  |\n|    |        | 3  |\n",
+		},
+		{
+			"only non-collapsing column repeats",
+			tablewriter.FormatMarkdown,
+			[]bool{false, true},
+			"| EU | Paris  | 10 |\n| EU |        | 12 |\n| EU | Berlin | 7  |\n| US | Paris  | 3  |\n| US |        | 3  |\n",
+		},
+		{
+			"csv unchanged",
+			tablewriter.FormatCSV,
+			[]bool{true, true},
+			"EU,Paris,12\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Format: tt.format, CollapseRepeats: tt.collapse}
+			out, err := tablewriter.Render(opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("Render() = %q, want it to contain %q", out, tt.want)
+			}
+		})
+	}
+}
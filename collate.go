@@ -0,0 +1,40 @@
+package tablewriter
+
+import "strings"
+
+// baseLetters maps lowercase accented Latin letters and ligatures to the
+// plain letters they sort with.
+var baseLetters = func() map[rune]string {
+	groups := []struct{ base, runes string }{
+		{"a", "àáâãäåāăą"}, {"c", "çćĉċč"}, {"d", "ďđð"}, {"e", "èéêëēĕėęě"},
+		{"g", "ĝğġģ"}, {"h", "ĥħ"}, {"i", "ìíîïĩīĭįı"}, {"j", "ĵ"}, {"k", "ķ"},
+		{"l", "ĺļľŀł"}, {"n", "ñńņňŉ"}, {"o", "òóôõöøōŏő"}, {"r", "ŕŗř"},
+		{"s", "śŝşš"}, {"t", "ţťŧ"}, {"u", "ùúûüũūŭůűų"}, {"w", "ŵ"},
+		{"y", "ýÿŷ"}, {"z", "źżž"}, {"ae", "æ"}, {"oe", "œ"}, {"ss", "ß"},
+		{"th", "þ"},
+	}
+	m := make(map[rune]string)
+	for _, g := range groups {
+		for _, r := range g.runes {
+			m[r] = g.base
+		}
+	}
+	return m
+}()
+
+// collationKey returns s lowercased with accents and ligatures on Latin
+// letters replaced by their base letters, so that strings compare in
+// dictionary order rather than code point order. It is a language-neutral
+// approximation: letters a particular language sorts after "z", such as
+// Swedish "ä", sort with their base letter.
+func collationKey(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if base, ok := baseLetters[r]; ok {
+			b.WriteString(base)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,93 @@
+package tablewriter
+
+// Column describes one column of a table. When Options.Columns is set it is
+// the single source of per-column configuration and takes precedence over
+// Headers, Alignments, MaxColumnWidths, Formatters, NullPlaceholders, and
+// ColumnPriorities, CollapseRepeats, ColumnTypes, Units, and SplitAnchors.
+type Column struct {
+	// Name is the header text.
+	Name string
+
+	// Alignment is the column's text alignment.
+	Alignment Alignment
+
+	// MaxWidth truncates this column's cells. 0 = use MaxColumnWidth.
+	MaxWidth int
+
+	// Formatter converts raw cell values for display. Nil = unchanged.
+	Formatter Formatter `json:"-"`
+
+	// NullPlaceholder replaces empty cells. "" = use Options.NullPlaceholder.
+	NullPlaceholder string
+
+	// Hidden excludes the column from rendered output. Its data is kept.
+	Hidden bool
+
+	// Priority is used by ResponsiveWidth; higher values are kept longer.
+	Priority int
+
+	// CollapseRepeats blanks cells equal to the one above in text formats.
+	CollapseRepeats bool
+
+	// Type declares the kind of data the column holds.
+	Type ColumnType
+
+	// SplitAnchor repeats the column in every sub-table of a split table.
+	SplitAnchor bool
+
+	// Unit is shown beneath the header, e.g. "ms"; see Options.Units.
+	Unit string
+}
+
+// WithColumns returns a copy of Options with the given column schema.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithColumns(
+//	    tablewriter.Column{Name: "Name"},
+//	    tablewriter.Column{Name: "Size", Alignment: tablewriter.AlignRight},
+//	)
+func (o Options) WithColumns(cols ...Column) Options {
+	o.Columns = cols
+	return o
+}
+
+// resolveColumns expands opts.Columns into the equivalent per-column slices.
+// It returns opts unchanged if Columns is empty.
+func resolveColumns(opts Options) Options {
+	if len(opts.Columns) == 0 {
+		return opts
+	}
+	n := len(opts.Columns)
+	opts.Headers = make([]string, n)
+	opts.Alignments = make([]Alignment, n)
+	opts.MaxColumnWidths = make([]int, n)
+	opts.Formatters = make([]Formatter, n)
+	opts.NullPlaceholders = make([]string, n)
+	opts.ColumnPriorities = make([]int, n)
+	opts.CollapseRepeats = make([]bool, n)
+	opts.ColumnTypes = make([]ColumnType, n)
+	opts.Units = make([]string, n)
+	opts.SplitAnchors = make([]bool, n)
+	for i, c := range opts.Columns {
+		opts.Headers[i] = c.Name
+		opts.Alignments[i] = c.Alignment
+		opts.MaxColumnWidths[i] = c.MaxWidth
+		opts.Formatters[i] = c.Formatter
+		opts.NullPlaceholders[i] = c.NullPlaceholder
+		opts.ColumnPriorities[i] = c.Priority
+		opts.CollapseRepeats[i] = c.CollapseRepeats
+		opts.ColumnTypes[i] = c.Type
+		opts.Units[i] = c.Unit
+		opts.SplitAnchors[i] = c.SplitAnchor
+	}
+	return opts
+}
+
+// dropHiddenColumns removes columns marked Hidden in opts.Columns.
+func dropHiddenColumns(opts Options, rows [][]string) (Options, [][]string) {
+	cols := opts.Columns
+	return selectColumns(opts, rows, func(col int) bool {
+		return col >= len(cols) || !cols[col].Hidden
+	})
+}
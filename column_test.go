@@ -0,0 +1,43 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestColumns(t *testing.T) {
+	opts := tablewriter.Options{
+		Format: tablewriter.FormatCSV,
+		Columns: []tablewriter.Column{
+			{Name: "Feature"},
+			{Name: "Internal ID", Hidden: true},
+			{Name: "Enabled", Formatter: tablewriter.BoolYesNo},
+			{Name: "Owner", NullPlaceholder: "—", MaxWidth: 6},
+		},
+		StrictColumnCount: true,
+	}
+	tbl := tablewriter.New(opts)
+	if err := tbl.AddRow("sso", "f-12", "true", ""); err != nil {
+		t.Fatalf("AddRow() error = %v", err)
+	}
+	if err := tbl.AddRow("audit", "f-13", "0", "platform"); err != nil {
+		t.Fatalf("AddRow() error = %v", err)
+	}
+	if err := tbl.AddRow("short"); !errors.Is(err, tablewriter.ErrColumnMismatch) {
+		t.Errorf("AddRow() error = %v, want %v", err, tablewriter.ErrColumnMismatch)
+	}
+	if got, want := tbl.Headers(), []string{"Feature", "Internal ID", "Enabled", "Owner"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Headers() = %q, want %q", got, want)
+	}
+
+	out, err := tbl.RenderErr()
+	if err != nil {
+		t.Fatalf("RenderErr() error = %v", err)
+	}
+	if want := "Feature,Enabled,Owner\nsso,yes,—\naudit,no,pla...\n"; out != want {
+		t.Errorf("RenderErr() = %q, want %q", out, want)
+	}
+}
@@ -0,0 +1,49 @@
+package tablewriter
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ErrInvalidColumnPattern is returned when an Options.ColumnFilter pattern
+// is malformed.
+var ErrInvalidColumnPattern = errors.New("tablewriter: invalid column pattern")
+
+// WithColumnFilter returns a copy of Options showing only the columns
+// selected by glob patterns; see Options.ColumnFilter.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithColumnFilter("host", "metric_*", "!metric_debug_*")
+func (o Options) WithColumnFilter(patterns ...string) Options {
+	o.ColumnFilter = patterns
+	return o
+}
+
+// filterColumns keeps the columns selected by opts.ColumnFilter.
+func filterColumns(opts Options, rows [][]string) (Options, [][]string, error) {
+	show := make([]bool, len(opts.Headers))
+	if strings.HasPrefix(opts.ColumnFilter[0], "!") {
+		for i := range show {
+			show[i] = true
+		}
+	}
+	for _, p := range opts.ColumnFilter {
+		pattern, hide := strings.CutPrefix(p, "!")
+		for i, h := range opts.Headers {
+			ok, err := path.Match(pattern, h)
+			if err != nil {
+				return opts, nil, fmt.Errorf("%w: %q", ErrInvalidColumnPattern, p)
+			}
+			if ok {
+				show[i] = !hide
+			}
+		}
+	}
+	opts, rows = selectColumns(opts, rows, func(col int) bool {
+		return col < len(show) && show[col]
+	})
+	return opts, rows, nil
+}
@@ -0,0 +1,42 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestColumnFilter(t *testing.T) {
+	headers := []string{"host", "metric_cpu", "metric_mem", "debug_id", "metric_debug_gc"}
+	row := []string{"web-1", "12", "40", "x", "3"}
+	tests := []struct {
+		name     string
+		patterns []string
+		want     string
+	}{
+		{"include", []string{"host", "metric_*"}, "host,metric_cpu,metric_mem,metric_debug_gc\nweb-1,12,40,3\n"},
+		{"include then exclude", []string{"host", "metric_*", "!metric_debug_*"}, "host,metric_cpu,metric_mem\nweb-1,12,40\n"},
+		{"exclude only", []string{"!debug_*", "!*_gc"}, "host,metric_cpu,metric_mem\nweb-1,12,40\n"},
+		{"character class", []string{"metric_[cm]*"}, "metric_cpu,metric_mem\n12,40\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Headers: headers, Format: tablewriter.FormatCSV}.WithColumnFilter(tt.patterns...)
+			got, err := tablewriter.Render(opts, [][]string{row})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColumnFilterInvalid(t *testing.T) {
+	opts := tablewriter.Options{Headers: []string{"a"}, ColumnFilter: []string{"[a"}}
+	if _, err := tablewriter.Render(opts, [][]string{{"1"}}); !errors.Is(err, tablewriter.ErrInvalidColumnPattern) {
+		t.Errorf("Render() error = %v, want %v", err, tablewriter.ErrInvalidColumnPattern)
+	}
+}
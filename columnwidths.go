@@ -0,0 +1,34 @@
+package tablewriter
+
+import "context"
+
+// ColumnWidths returns the content width and alignment of each column as the
+// renderer would lay them out with the table's current rows and options,
+// after formatting, truncation, wrapping, and width fitting. Widths exclude
+// borders and padding. Callers can use them to align captions, legends, or
+// progress lines with the table's columns.
+//
+// Example:
+//
+//	widths, aligns, err := t.ColumnWidths()
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(strings.Repeat("=", widths[0]))
+func (t *Table) ColumnWidths() ([]int, []Alignment, error) {
+	ctx := context.Background()
+	opts, rows, err := prepare(t.withWidthCache(t.opts, widthUseColumns), t.rows, t.meta)
+	if err != nil {
+		return nil, nil, err
+	}
+	if opts, rows, err = sizeColumns(ctx, opts, rows); err != nil {
+		return nil, nil, err
+	}
+	widths, err := colWidths(ctx, opts, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	aligns := make([]Alignment, len(widths))
+	copy(aligns, opts.Alignments)
+	return widths, aligns, nil
+}
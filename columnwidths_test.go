@@ -0,0 +1,50 @@
+package tablewriter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestColumnWidths(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       tablewriter.Options
+		wantWidths []int
+		wantAligns []tablewriter.Alignment
+	}{
+		{
+			"natural",
+			tablewriter.Options{Headers: []string{"Name", "Size"}, Alignments: []tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignRight}},
+			[]int{10, 5},
+			[]tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignRight},
+		},
+		{
+			"truncated",
+			tablewriter.Options{Headers: []string{"Name", "Size"}, MaxColumnWidth: 6},
+			[]int{6, 5},
+			[]tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignLeft},
+		},
+		{
+			"typed",
+			tablewriter.Options{Headers: []string{"Name", "Size"}, ColumnTypes: []tablewriter.ColumnType{tablewriter.TypeString, tablewriter.TypeInt}},
+			[]int{10, 5},
+			[]tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignRight},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := tablewriter.New(tt.opts)
+			tbl.AddRow("readme.txt", "12345")
+			tbl.AddRow("a", "1")
+			widths, aligns, err := tbl.ColumnWidths()
+			if err != nil {
+				t.Fatalf("ColumnWidths() error = %v", err)
+			}
+			if !reflect.DeepEqual(widths, tt.wantWidths) || !reflect.DeepEqual(aligns, tt.wantAligns) {
+				t.Errorf("ColumnWidths() = %v, %v, want %v, %v", widths, aligns, tt.wantWidths, tt.wantAligns)
+			}
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package tablewriter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// CSVDialect selects conventions for FormatCSV output aimed at a particular
+// consumer.
+type CSVDialect int
+
+const (
+	// CSVStandard writes RFC 4180 comma-separated values (default).
+	CSVStandard CSVDialect = iota
+
+	// CSVExcel starts the output with a "sep=" hint line that makes Excel
+	// split columns correctly regardless of the user's regional settings,
+	// and defaults the delimiter to ExcelListSeparator.
+	CSVExcel
+
+	// CSVSheetsTSV writes tab-separated values for pasting or importing into
+	// Google Sheets: fields are never quoted, and tabs and line breaks inside
+	// cells become spaces so they cannot split a row.
+	CSVSheetsTSV
+)
+
+// CSVQuoting selects which FormatCSV fields are enclosed in quotes.
+type CSVQuoting int
+
+const (
+	CSVQuoteMinimal    CSVQuoting = iota // CSVQuoteMinimal quotes only fields that need it (default).
+	CSVQuoteAll                          // CSVQuoteAll quotes every field, including headers.
+	CSVQuoteNonNumeric                   // CSVQuoteNonNumeric quotes every field that is not a number.
+)
+
+// decimalCommaLanguages lists languages whose locales write decimals with a
+// comma and therefore use ";" as the list separator in Excel.
+var decimalCommaLanguages = map[string]bool{
+	"bg": true, "cs": true, "da": true, "de": true, "el": true, "es": true,
+	"et": true, "fi": true, "fr": true, "hr": true, "hu": true, "id": true,
+	"it": true, "lt": true, "lv": true, "nb": true, "nl": true, "nn": true,
+	"no": true, "pl": true, "pt": true, "ro": true, "ru": true, "sk": true,
+	"sl": true, "sr": true, "sv": true, "tr": true, "uk": true,
+}
+
+// ExcelListSeparator returns the list separator Excel uses for the current
+// locale, taken from the LC_ALL, LC_NUMERIC, or LANG environment variable:
+// ';' for locales with a decimal comma such as de_DE, ',' otherwise.
+//
+// Example:
+//
+//	opts := tablewriter.Options{Format: tablewriter.FormatCSV, CSVDialect: tablewriter.CSVExcel}
+//	fmt.Printf("using %q\n", tablewriter.ExcelListSeparator())
+func ExcelListSeparator() rune {
+	for _, name := range []string{"LC_ALL", "LC_NUMERIC", "LANG"} {
+		locale := os.Getenv(name)
+		if locale == "" {
+			continue
+		}
+		lang, _, _ := strings.Cut(strings.ToLower(locale), "_")
+		lang, _, _ = strings.Cut(lang, ".")
+		if decimalCommaLanguages[lang] {
+			return ';'
+		}
+		return ','
+	}
+	return ','
+}
+
+// customCSV reports whether opts asks for CSV output that differs from the
+// standard renderer.
+func customCSV(opts Options) bool {
+	return opts.CSVDialect != CSVStandard || opts.CSVDelimiter != 0 || opts.CSVNull != "" ||
+		opts.CSVQuoting != CSVQuoteMinimal || opts.CSVEscape != 0
+}
+
+// csvDelimiter returns the field delimiter for opts.
+func csvDelimiter(opts Options) rune {
+	switch {
+	case opts.CSVDelimiter != 0:
+		return opts.CSVDelimiter
+	case opts.CSVDialect == CSVExcel:
+		return ExcelListSeparator()
+	case opts.CSVDialect == CSVSheetsTSV:
+		return '\t'
+	default:
+		return ','
+	}
+}
+
+// renderCSVDialect renders rows as delimited text according to the CSV
+// options in opts.
+func renderCSVDialect(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	delim := csvDelimiter(opts)
+	var b strings.Builder
+	if opts.CSVDialect == CSVExcel {
+		b.WriteString("sep=" + string(delim) + "\n")
+	}
+	if len(opts.Headers) > 0 {
+		writeCSVRecord(&b, opts, opts.Headers, delim, nil)
+	}
+	for _, r := range rows {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		cells := make([]string, len(r))
+		nulls := make([]bool, len(r))
+		for i, c := range r {
+			if opts.CSVNull != "" && (c == Null || (c == "" && !opts.ExplicitNulls)) {
+				nulls[i] = true // written as the null token
+				continue
+			}
+			v, err := applyCellOpts(c, opts)
+			if err != nil {
+				return "", err
+			}
+			cells[i] = v
+		}
+		writeCSVRecord(&b, opts, cells, delim, nulls)
+	}
+	return b.String(), nil
+}
+
+// writeCSVRecord writes one line of delimited fields. Fields marked in nulls
+// are written as opts.CSVNull, unquoted.
+func writeCSVRecord(b *strings.Builder, opts Options, fields []string, delim rune, nulls []bool) {
+	for i, f := range fields {
+		if i > 0 {
+			b.WriteRune(delim)
+		}
+		if i < len(nulls) && nulls[i] {
+			b.WriteString(opts.CSVNull)
+			continue
+		}
+		b.WriteString(csvField(opts, f, delim))
+	}
+	b.WriteString("\n")
+}
+
+// csvField returns f encoded as a single field. Standard and Excel output
+// quote fields as opts.CSVQuoting requires, doubling embedded quotes or,
+// with CSVEscape, prefixing quotes and the escape character with it; Sheets
+// TSV replaces tabs and line breaks with spaces instead.
+func csvField(opts Options, f string, delim rune) string {
+	if opts.CSVDialect == CSVSheetsTSV {
+		return strings.NewReplacer("\r\n", " ", "\t", " ", "\n", " ", "\r", " ").Replace(f)
+	}
+	quote := csvNeedsQuotes(opts.CSVQuoting, f, delim)
+	if esc := string(opts.CSVEscape); opts.CSVEscape != 0 {
+		f = strings.NewReplacer(esc, esc+esc, `"`, esc+`"`).Replace(f)
+	} else if quote {
+		f = strings.ReplaceAll(f, `"`, `""`)
+	}
+	if !quote {
+		return f
+	}
+	return `"` + f + `"`
+}
+
+// csvNeedsQuotes reports whether field f must be quoted under policy q.
+// Fields containing the delimiter, a quote, a line break, or leading space
+// are always quoted.
+func csvNeedsQuotes(q CSVQuoting, f string, delim rune) bool {
+	switch q {
+	case CSVQuoteAll:
+		return true
+	case CSVQuoteNonNumeric:
+		if _, err := strconv.ParseFloat(f, 64); err != nil {
+			return true
+		}
+	}
+	return f != "" && (strings.ContainsRune(f, delim) || strings.ContainsAny(f, "\"\r\n") || f[0] == ' ' || f[0] == '\t')
+}
+
+// csvDialectNames maps each CSVDialect to its name in configuration files.
+var csvDialectNames = map[CSVDialect]string{
+	CSVStandard:  "standard",
+	CSVExcel:     "excel",
+	CSVSheetsTSV: "sheets-tsv",
+}
+
+// MarshalText encodes d by name, e.g. "excel", implementing
+// encoding.TextMarshaler.
+func (d CSVDialect) MarshalText() ([]byte, error) {
+	return marshalEnum(d, csvDialectNames, "CSVDialect")
+}
+
+// UnmarshalText parses a name written by MarshalText, ignoring case,
+// implementing encoding.TextUnmarshaler.
+func (d *CSVDialect) UnmarshalText(text []byte) error {
+	v, err := unmarshalEnum(text, csvDialectNames, "CSVDialect")
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// csvQuotingNames maps each CSVQuoting to its name in configuration files.
+var csvQuotingNames = map[CSVQuoting]string{
+	CSVQuoteMinimal:    "minimal",
+	CSVQuoteAll:        "all",
+	CSVQuoteNonNumeric: "non-numeric",
+}
+
+// MarshalText encodes q by name, e.g. "non-numeric", implementing
+// encoding.TextMarshaler.
+func (q CSVQuoting) MarshalText() ([]byte, error) {
+	return marshalEnum(q, csvQuotingNames, "CSVQuoting")
+}
+
+// UnmarshalText parses a name written by MarshalText, ignoring case,
+// implementing encoding.TextUnmarshaler.
+func (q *CSVQuoting) UnmarshalText(text []byte) error {
+	v, err := unmarshalEnum(text, csvQuotingNames, "CSVQuoting")
+	if err != nil {
+		return err
+	}
+	*q = v
+	return nil
+}
+
+// WithCSVDialect returns a copy of Options that tailors FormatCSV output to
+// d. Returns ErrInvalidOptions if d is not a known dialect.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithCSVDialect(tablewriter.CSVExcel)
+func (o Options) WithCSVDialect(d CSVDialect) (Options, error) {
+	if _, ok := csvDialectNames[d]; !ok {
+		return o, fmt.Errorf("invalid CSV dialect %d: %w", int(d), ErrInvalidOptions)
+	}
+	o.CSVDialect = d
+	return o, nil
+}
+
+// WithCSVDelimiter returns a copy of Options with the FormatCSV field
+// delimiter r; 0 restores the dialect's default. Returns ErrInvalidOptions
+// for a quote, a line break, or an invalid rune.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithCSVDelimiter(';')
+func (o Options) WithCSVDelimiter(r rune) (Options, error) {
+	if r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError || (r != 0 && !utf8.ValidRune(r)) {
+		return o, fmt.Errorf("invalid CSV delimiter %q: %w", r, ErrInvalidOptions)
+	}
+	o.CSVDelimiter = r
+	return o, nil
+}
+
+// WithCSVNull returns a copy of Options that writes s, unquoted, for empty
+// FormatCSV cells. Returns ErrInvalidOptions if s contains a quote or a
+// line break, which unquoted output cannot hold.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithCSVNull(`\N`)
+func (o Options) WithCSVNull(s string) (Options, error) {
+	if strings.ContainsAny(s, "\"\r\n") {
+		return o, fmt.Errorf("invalid CSV null %q: %w", s, ErrInvalidOptions)
+	}
+	o.CSVNull = s
+	return o, nil
+}
+
+// WithCSVQuoting returns a copy of Options that quotes FormatCSV fields as
+// q selects. Returns ErrInvalidOptions if q is not a known mode.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithCSVQuoting(tablewriter.CSVQuoteAll)
+func (o Options) WithCSVQuoting(q CSVQuoting) (Options, error) {
+	if _, ok := csvQuotingNames[q]; !ok {
+		return o, fmt.Errorf("invalid CSV quoting %d: %w", int(q), ErrInvalidOptions)
+	}
+	o.CSVQuoting = q
+	return o, nil
+}
+
+// WithCSVEscape returns a copy of Options that escapes quotes inside
+// FormatCSV fields with r instead of doubling them; 0 restores doubling.
+// Returns ErrInvalidOptions for a quote, a line break, or an invalid rune.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithCSVEscape('\\')
+func (o Options) WithCSVEscape(r rune) (Options, error) {
+	if r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError || (r != 0 && !utf8.ValidRune(r)) {
+		return o, fmt.Errorf("invalid CSV escape %q: %w", r, ErrInvalidOptions)
+	}
+	o.CSVEscape = r
+	return o, nil
+}
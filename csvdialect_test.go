@@ -0,0 +1,222 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestCSVDialect(t *testing.T) {
+	rows := [][]string{{"Müller", "1,5"}, {"tab\there", "line\nbreak"}}
+	tests := []struct {
+		name    string
+		lang    string
+		dialect tablewriter.CSVDialect
+		delim   rune
+		want    string
+	}{
+		{
+			"excel german locale",
+			"de_DE.UTF-8",
+			tablewriter.CSVExcel,
+			0,
+			"sep=;\nName;Value\nMüller;1,5\ntab\there;\"line\nbreak\"\n",
+		},
+		{
+			"excel english locale",
+			"en_US.UTF-8",
+			tablewriter.CSVExcel,
+			0,
+			"sep=,\nName,Value\nMüller,\"1,5\"\ntab\there,\"line\nbreak\"\n",
+		},
+		{
+			"sheets tsv",
+			"",
+			tablewriter.CSVSheetsTSV,
+			0,
+			"Name\tValue\nMüller\t1,5\ntab here\tline break\n",
+		},
+		{
+			"standard with delimiter",
+			"",
+			tablewriter.CSVStandard,
+			'|',
+			"Name|Value\nMüller|1,5\ntab\there|\"line\nbreak\"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LC_ALL", "")
+			t.Setenv("LC_NUMERIC", "")
+			t.Setenv("LANG", tt.lang)
+			opts := tablewriter.Options{
+				Headers:      []string{"Name", "Value"},
+				Format:       tablewriter.FormatCSV,
+				CSVDialect:   tt.dialect,
+				CSVDelimiter: tt.delim,
+			}
+			out, err := tablewriter.Render(opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSVNull(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers:         []string{"id", "name", "note"},
+		Format:          tablewriter.FormatCSV,
+		CSVNull:         `\N`,
+		NullPlaceholder: "-",
+	}
+	out, err := tablewriter.Render(opts, [][]string{{"1", "", "a,b"}, {"2", "Bob", ""}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	want := "id,name,note\n1,\\N,\"a,b\"\n2,Bob,\\N\n"
+	if out != want {
+		t.Errorf("Render() = %q, want %q", out, want)
+	}
+}
+
+func TestCSVQuoting(t *testing.T) {
+	rows := [][]string{{"Alice", "30", "a,b"}}
+	tests := []struct {
+		name    string
+		quoting tablewriter.CSVQuoting
+		want    string
+	}{
+		{"minimal", tablewriter.CSVQuoteMinimal, "Name,Age,Note\nAlice,30,\"a,b\"\n"},
+		{"all", tablewriter.CSVQuoteAll, "\"Name\",\"Age\",\"Note\"\n\"Alice\",\"30\",\"a,b\"\n"},
+		{"non-numeric", tablewriter.CSVQuoteNonNumeric, "\"Name\",\"Age\",\"Note\"\n\"Alice\",30,\"a,b\"\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:    []string{"Name", "Age", "Note"},
+				Format:     tablewriter.FormatCSV,
+				CSVQuoting: tt.quoting,
+			}
+			out, err := tablewriter.Render(opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSVEscape(t *testing.T) {
+	opts := tablewriter.Options{Format: tablewriter.FormatCSV, CSVEscape: '\\'}
+	out, err := tablewriter.Render(opts, [][]string{{`say "hi"`, `C:\tmp`, "plain"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	want := `"say \"hi\"",C:\\tmp,plain` + "\n"
+	if out != want {
+		t.Errorf("Render() = %q, want %q", out, want)
+	}
+}
+
+func TestWithCSVDialect(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect tablewriter.CSVDialect
+		delim   rune
+		want    string
+		wantErr bool
+	}{
+		{"excel semicolon", tablewriter.CSVExcel, ';', "sep=;\nA;B\n1;2\n", false},
+		{"sheets", tablewriter.CSVSheetsTSV, 0, "A\tB\n1\t2\n", false},
+		{"unknown dialect", tablewriter.CSVDialect(9), 0, "", true},
+		{"quote delimiter", tablewriter.CSVStandard, '"', "", true},
+		{"newline delimiter", tablewriter.CSVStandard, '\n', "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := tablewriter.DefaultOptions().WithCSVDialect(tt.dialect)
+			if err == nil {
+				opts, err = opts.WithCSVDelimiter(tt.delim)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, tablewriter.ErrInvalidOptions) {
+					t.Errorf("error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			opts = opts.WithHeaders("A", "B")
+			opts.Format = tablewriter.FormatCSV
+			out, err := tablewriter.Render(opts, [][]string{{"1", "2"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithCSVNull(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithCSVNull("a\nb"); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithCSVNull(line break) error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+	opts, err := tablewriter.DefaultOptions().WithCSVNull(`\N`)
+	if err != nil {
+		t.Fatalf("WithCSVNull() error = %v", err)
+	}
+	opts.Format = tablewriter.FormatCSV
+	out, err := tablewriter.Render(opts, [][]string{{"1", ""}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "1,\\N\n"; out != want {
+		t.Errorf("Render() = %q, want %q", out, want)
+	}
+}
+
+func TestWithCSVQuoting(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithCSVQuoting(tablewriter.CSVQuoting(9)); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithCSVQuoting(9) error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+	opts, err := tablewriter.DefaultOptions().WithCSVQuoting(tablewriter.CSVQuoteAll)
+	if err != nil {
+		t.Fatalf("WithCSVQuoting() error = %v", err)
+	}
+	opts.Format = tablewriter.FormatCSV
+	out, err := tablewriter.Render(opts, [][]string{{"1", "a"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "\"1\",\"a\"\n"; out != want {
+		t.Errorf("Render() = %q, want %q", out, want)
+	}
+}
+
+func TestWithCSVEscape(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithCSVEscape('"'); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithCSVEscape('\"') error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+	opts, err := tablewriter.DefaultOptions().WithCSVEscape('\\')
+	if err != nil {
+		t.Fatalf("WithCSVEscape() error = %v", err)
+	}
+	opts.Format = tablewriter.FormatCSV
+	out, err := tablewriter.Render(opts, [][]string{{`say "hi"`}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := `"say \"hi\""` + "\n"; out != want {
+		t.Errorf("Render() = %q, want %q", out, want)
+	}
+}
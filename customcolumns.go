@@ -0,0 +1,115 @@
+package tablewriter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrInvalidCustomColumns is returned when a custom-columns spec cannot be parsed.
+var ErrInvalidCustomColumns = errors.New("tablewriter: invalid custom-columns spec")
+
+// CustomColumn is one HEADER:.path entry of a kubectl-style custom-columns spec.
+type CustomColumn struct {
+	// Header is the column name shown in the table.
+	Header string
+
+	// Path is the dot-separated field path, e.g. ".Metadata.Name".
+	Path string
+}
+
+// ParseCustomColumns parses a kubectl-style custom-columns spec such as
+// "NAME:.Name,AGE:.Age".
+//
+// Example:
+//
+//	cols, err := tablewriter.ParseCustomColumns("NAME:.Name,AGE:.Age")
+func ParseCustomColumns(spec string) ([]CustomColumn, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, fmt.Errorf("%w: empty spec", ErrInvalidCustomColumns)
+	}
+	var cols []CustomColumn
+	for _, part := range strings.Split(spec, ",") {
+		header, path, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok || header == "" || !strings.HasPrefix(path, ".") {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidCustomColumns, part)
+		}
+		cols = append(cols, CustomColumn{Header: header, Path: path})
+	}
+	return cols, nil
+}
+
+// CustomColumnRows evaluates a custom-columns spec against items, which must
+// be a slice of structs, maps with string keys, or pointers to either. It
+// returns the headers and one row per item. Missing fields render as "<none>",
+// like kubectl.
+//
+// Example:
+//
+//	headers, rows, err := tablewriter.CustomColumnRows("NAME:.Name,AGE:.Age", people)
+//	out, err := tablewriter.Render(tablewriter.Options{Headers: headers}, rows)
+func CustomColumnRows(spec string, items any) ([]string, [][]string, error) {
+	cols, err := ParseCustomColumns(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, nil, fmt.Errorf("%w: items must be a slice, got %T", ErrInvalidCustomColumns, items)
+	}
+
+	headers := make([]string, len(cols))
+	for i, c := range cols {
+		headers[i] = c.Header
+	}
+	rows := make([][]string, v.Len())
+	for i := range rows {
+		row := make([]string, len(cols))
+		for j, c := range cols {
+			row[j] = lookupPath(v.Index(i), c.Path)
+		}
+		rows[i] = row
+	}
+	return headers, rows, nil
+}
+
+// lookupPath follows a dot-separated path through structs and maps and
+// formats the value it ends at.
+func lookupPath(v reflect.Value, path string) string {
+	for _, name := range strings.Split(strings.TrimPrefix(path, "."), ".") {
+		if name == "" {
+			continue
+		}
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return "<none>"
+			}
+			v = v.Elem()
+		}
+		switch v.Kind() {
+		case reflect.Struct:
+			v = v.FieldByName(name)
+		case reflect.Map:
+			if v.Type().Key().Kind() != reflect.String {
+				return "<none>"
+			}
+			v = v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		default:
+			return "<none>"
+		}
+		if !v.IsValid() {
+			return "<none>"
+		}
+	}
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "<none>"
+		}
+		v = v.Elem()
+	}
+	if !v.CanInterface() {
+		return "<none>"
+	}
+	return fmt.Sprint(v.Interface())
+}
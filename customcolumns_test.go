@@ -0,0 +1,96 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+type pod struct {
+	Name   string
+	Age    int
+	Labels map[string]string
+	Node   *node
+}
+
+type node struct {
+	Name string
+}
+
+func TestCustomColumnRows(t *testing.T) {
+	pods := []pod{
+		{Name: "web", Age: 3, Labels: map[string]string{"app": "nginx"}, Node: &node{Name: "n1"}},
+		{Name: "db", Age: 7},
+	}
+	tests := []struct {
+		name     string
+		spec     string
+		wantHdrs []string
+		wantRows [][]string
+		wantErr  error
+	}{{
+		"fields",
+		"NAME:.Name,AGE:.Age",
+		[]string{"NAME", "AGE"},
+		[][]string{{"web", "3"}, {"db", "7"}},
+		nil,
+	}, {
+		"nested and missing",
+		"APP:.Labels.app,NODE:.Node.Name",
+		[]string{"APP", "NODE"},
+		[][]string{{"nginx", "n1"}, {"<none>", "<none>"}},
+		nil,
+	}, {
+		"bad spec",
+		"NAME=.Name",
+		nil,
+		nil,
+		tablewriter.ErrInvalidCustomColumns,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hdrs, rows, err := tablewriter.CustomColumnRows(tt.spec, pods)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CustomColumnRows() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(hdrs, tt.wantHdrs) || !reflect.DeepEqual(rows, tt.wantRows) {
+				t.Errorf("CustomColumnRows() = %q, %q, want %q, %q", hdrs, rows, tt.wantHdrs, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestWideColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		wide    bool
+		wantIP  bool
+		wantOut string
+	}{
+		{"narrow", false, false, "web,Running\n"},
+		{"wide", true, true, "web,Running,10.0.0.1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:     []string{"NAME", "STATUS", "IP"},
+				Format:      tablewriter.FormatCSV,
+				WideColumns: []string{"IP"},
+				Wide:        tt.wide,
+			}
+			out, err := tablewriter.Render(opts, [][]string{{"web", "Running", "10.0.0.1"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if strings.Contains(out, "IP") != tt.wantIP {
+				t.Errorf("Render() contains IP header = %v, want %v:\n%s", !tt.wantIP, tt.wantIP, out)
+			}
+			if !strings.Contains(out, tt.wantOut) {
+				t.Errorf("Render() = %q, want it to contain %q", out, tt.wantOut)
+			}
+		})
+	}
+}
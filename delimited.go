@@ -0,0 +1,135 @@
+package tablewriter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// sniffDelimiters are the delimiters SniffDelimiter chooses from, in order
+// of preference when equally likely.
+var sniffDelimiters = []rune{',', '\t', ';', '|'}
+
+// sniffLines is how many lines SniffDelimiter examines.
+const sniffLines = 20
+
+// ReadDelimited reads delimited text, such as CSV or text pasted from a
+// spreadsheet, into a Table with opts. The delimiter is opts.CSVDelimiter
+// if set, otherwise detected with SniffDelimiter. If opts.Headers is empty
+// the first record becomes the headers when it looks like a header row:
+// its cells are non-empty and distinct, and none is a number, boolean, or
+// time in a column whose other values all are. Otherwise every record is a
+// row. Quoted fields, ragged records, and a UTF-8 byte order mark are
+// accepted.
+//
+// Example:
+//
+//	t, err := tablewriter.ReadDelimited(os.Stdin, tablewriter.DefaultOptions())
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Print(t.Render())
+func ReadDelimited(r io.Reader, opts Options) (*Table, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+	if opts.CSVDelimiter == 0 {
+		opts.CSVDelimiter = SniffDelimiter(string(data))
+	}
+	cr := csv.NewReader(bytes.NewReader(data))
+	cr.Comma = opts.CSVDelimiter
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("tablewriter: reading delimited text: %w", err)
+	}
+	if len(opts.Headers) == 0 && len(opts.Columns) == 0 && looksLikeHeader(records) {
+		opts.Headers, records = records[0], records[1:]
+	}
+	t := New(opts)
+	if err := t.AddRows(records); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// SniffDelimiter guesses which of comma, tab, semicolon, or pipe separates
+// the fields of the delimited text in sample, from its first lines. The
+// winner appears the same number of times on the most lines, ignoring
+// quoted fields; ties go to the most occurrences, then to the order above.
+// Text with none of them is assumed to be comma-separated.
+//
+// Example:
+//
+//	delim := tablewriter.SniffDelimiter(pasted) // '\t' for spreadsheet data
+func SniffDelimiter(sample string) rune {
+	lines := strings.Split(strings.ReplaceAll(sample, "\r\n", "\n"), "\n")
+	var nonBlank []string
+	for _, l := range lines {
+		if strings.TrimSpace(l) != "" {
+			nonBlank = append(nonBlank, l)
+		}
+		if len(nonBlank) == sniffLines {
+			break
+		}
+	}
+	best, bestLines, bestCount := ',', 0, 0
+	for _, d := range sniffDelimiters {
+		freq := map[int]int{}
+		for _, l := range nonBlank {
+			if n := countUnquoted(l, d); n > 0 {
+				freq[n]++
+			}
+		}
+		for count, lines := range freq {
+			if lines > bestLines || (lines == bestLines && count > bestCount) {
+				best, bestLines, bestCount = d, lines, count
+			}
+		}
+	}
+	return best
+}
+
+// countUnquoted counts the occurrences of d in line outside double quotes.
+func countUnquoted(line string, d rune) int {
+	n, quoted := 0, false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == d && !quoted:
+			n++
+		}
+	}
+	return n
+}
+
+// looksLikeHeader reports whether the first of records reads as a header
+// row: its cells are non-empty and distinct, and no column whose remaining
+// values share a numeric, boolean, or time type has a first value of that
+// type too.
+func looksLikeHeader(records [][]string) bool {
+	if len(records) == 0 {
+		return false
+	}
+	seen := make(map[string]bool, len(records[0]))
+	for _, h := range records[0] {
+		if strings.TrimSpace(h) == "" || seen[h] {
+			return false
+		}
+		seen[h] = true
+	}
+	for i, h := range records[0] {
+		if t := inferColumnType(records[1:], i); t != TypeString {
+			if _, ok := t.parse(h); ok {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,86 @@
+package tablewriter_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestSniffDelimiter(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want rune
+	}{
+		{"comma", "a,b,c\n1,2,3\n", ','},
+		{"tab", "name\tnote\nalice\tx, y, z\nbob\tw\n", '\t'},
+		{"semicolon", "Name;Price\nWidget;1,50\nGadget;2,75\n", ';'},
+		{"pipe", "a|b\n1|2\n", '|'},
+		{"quoted", "\"a;b\",c\n\"d;e\",f\n", ','},
+		{"none", "single\ncolumn\n", ','},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tablewriter.SniffDelimiter(tt.in); got != tt.want {
+				t.Errorf("SniffDelimiter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadDelimited(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		opts    tablewriter.Options
+		headers []string
+		rows    [][]string
+	}{
+		{
+			name:    "header detected",
+			in:      "\ufeffName;Qty\nwidget;3\ngadget;12\n",
+			headers: []string{"Name", "Qty"},
+			rows:    [][]string{{"widget", "3"}, {"gadget", "12"}},
+		},
+		{
+			name: "numeric first row",
+			in:   "1\t2\n3\t4\n",
+			rows: [][]string{{"1", "2"}, {"3", "4"}},
+		},
+		{
+			name: "blank first cell",
+			in:   ",x\na,b\n",
+			rows: [][]string{{"", "x"}, {"a", "b"}},
+		},
+		{
+			name:    "explicit headers",
+			in:      "id,name\n1,alice\n",
+			opts:    tablewriter.Options{Headers: []string{"A", "B"}},
+			headers: []string{"A", "B"},
+			rows:    [][]string{{"id", "name"}, {"1", "alice"}},
+		},
+		{
+			name:    "explicit delimiter",
+			in:      "a:b\nc:d\n",
+			opts:    tablewriter.Options{CSVDelimiter: ':'},
+			headers: []string{"a", "b"},
+			rows:    [][]string{{"c", "d"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl, err := tablewriter.ReadDelimited(strings.NewReader(tt.in), tt.opts)
+			if err != nil {
+				t.Fatalf("ReadDelimited() error = %v", err)
+			}
+			if got := tbl.Headers(); len(got)+len(tt.headers) > 0 && !reflect.DeepEqual(got, tt.headers) {
+				t.Errorf("Headers() = %q, want %q", got, tt.headers)
+			}
+			if got := tbl.Rows(); !reflect.DeepEqual(got, tt.rows) {
+				t.Errorf("Rows() = %q, want %q", got, tt.rows)
+			}
+		})
+	}
+}
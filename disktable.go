@@ -0,0 +1,315 @@
+package tablewriter
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ErrStreamUnsupported is returned by DiskTable.WriteTo for formats that
+// need every row in memory to render, such as FormatJSON.
+var ErrStreamUnsupported = errors.New("tablewriter: format cannot be streamed")
+
+// DiskTable accumulates rows in a temporary file instead of memory, for
+// tables of millions of rows. It keeps only an index of row offsets and the
+// running column widths in memory, and streams the table out with WriteTo.
+// Rows are checked against StrictColumnCount and their cells processed as
+// they are added, by Formatters, NullPlaceholder, NullPlaceholders,
+// ExplicitNulls, CSVNull, and MaxColumnWidth; options that need every row at
+// once, such as sorting, filtering, and OmitEmptyColumns, are ignored. A DiskTable is not safe for
+// concurrent use. Close removes the file.
+type DiskTable struct {
+	opts    Options
+	headers []string
+	aligns  []Alignment
+	widths  []int
+
+	f       *os.File
+	w       *bufio.Writer
+	size    int64
+	offsets []int64 // start of each row in f
+	buf     []byte
+}
+
+// NewDiskTable creates a DiskTable with the provided Options whose rows are
+// stored in a new file in dir, or in os.TempDir if dir is "".
+//
+// Example:
+//
+//	dt, err := tablewriter.NewDiskTable(tablewriter.Options{
+//	    Headers: []string{"ID", "Event"},
+//	    Format:  tablewriter.FormatCSV,
+//	}, "")
+//	if err != nil {
+//	    return err
+//	}
+//	defer dt.Close()
+func NewDiskTable(opts Options, dir string) (*DiskTable, error) {
+	opts = resolveColumns(opts)
+	if hasColumnTypes(opts) {
+		opts.Alignments = typedAlignments(opts)
+	}
+	f, err := os.CreateTemp(dir, "tablewriter-*.rows")
+	if err != nil {
+		return nil, err
+	}
+	d := &DiskTable{opts: opts, aligns: opts.Alignments, f: f, w: bufio.NewWriter(f)}
+	for _, h := range opts.Headers {
+		d.headers = append(d.headers, applyHeaderOpts(headerLabel(h, opts), opts))
+	}
+	d.measure(d.headers)
+	return d, nil
+}
+
+// AddRow appends a row to the file.
+// Returns ErrColumnMismatch if StrictColumnCount is true and counts differ.
+//
+// Example:
+//
+//	for ev := range events {
+//	    if err := dt.AddRow(ev.ID, ev.Name); err != nil {
+//	        return err
+//	    }
+//	}
+func (d *DiskTable) AddRow(cols ...string) error {
+	if n := len(d.headers); d.opts.StrictColumnCount && n > 0 && len(cols) != n {
+		return ErrColumnMismatch
+	}
+	cells := make([]string, len(cols))
+	for i, c := range cols {
+		if i < len(d.opts.Formatters) && d.opts.Formatters[i] != nil && c != Null {
+			c = d.opts.Formatters[i](c)
+		}
+		cells[i] = d.cell(i, c)
+	}
+	d.measure(cells)
+
+	d.buf = binary.AppendUvarint(d.buf[:0], uint64(len(cells)))
+	for _, c := range cells {
+		d.buf = binary.AppendUvarint(d.buf, uint64(len(c)))
+		d.buf = append(d.buf, c...)
+	}
+	if _, err := d.w.Write(d.buf); err != nil {
+		return err
+	}
+	d.offsets = append(d.offsets, d.size)
+	d.size += int64(len(d.buf))
+	return nil
+}
+
+// RowCount returns the number of rows added.
+//
+// Example:
+//
+//	fmt.Println(dt.RowCount(), "rows buffered")
+func (d *DiskTable) RowCount() int {
+	return len(d.offsets)
+}
+
+// Row returns the processed cells of row i, read back from the file. Cells
+// FormatCSV writes as the CSVNull token are Null.
+//
+// Example:
+//
+//	last, err := dt.Row(dt.RowCount() - 1)
+func (d *DiskTable) Row(i int) ([]string, error) {
+	if i < 0 || i >= len(d.offsets) {
+		return nil, fmt.Errorf("%w: %d", ErrRowOutOfRange, i)
+	}
+	if err := d.w.Flush(); err != nil {
+		return nil, err
+	}
+	r := bufio.NewReader(io.NewSectionReader(d.f, d.offsets[i], d.size-d.offsets[i]))
+	return readDiskRow(r)
+}
+
+// WriteTo streams the table to w in FormatPlain, FormatSimple,
+// FormatMarkdown, or FormatCSV, laid out in the widths of the widest cells
+// added, and returns the number of bytes written. Other formats return
+// ErrStreamUnsupported.
+//
+// Example:
+//
+//	if _, err := dt.WriteTo(os.Stdout); err != nil {
+//	    return err
+//	}
+func (d *DiskTable) WriteTo(w io.Writer) (int64, error) {
+	if !isTextFormat(d.opts.Format) && d.opts.Format != FormatCSV {
+		return 0, fmt.Errorf("%w: %s", ErrStreamUnsupported, d.opts.Format)
+	}
+	if err := d.w.Flush(); err != nil {
+		return 0, err
+	}
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+	r := bufio.NewReader(io.NewSectionReader(d.f, 0, d.size))
+	d.writeHeader(cw)
+	for range d.offsets {
+		cells, err := readDiskRow(r)
+		if err != nil {
+			return cw.n, err
+		}
+		d.writeLine(cw, cells)
+	}
+	if d.opts.Format == FormatPlain {
+		io.WriteString(cw, d.rule("└", "┴", "┘")+"\n")
+	}
+	err := cw.w.(*bufio.Writer).Flush()
+	if err == nil {
+		err = cw.err
+	}
+	return cw.n, err
+}
+
+// Close closes and removes the file backing the table.
+//
+// Example:
+//
+//	defer dt.Close()
+func (d *DiskTable) Close() error {
+	err := d.f.Close()
+	if rmErr := os.Remove(d.f.Name()); err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+// cell returns c, the cell of column i, with the null options resolved and
+// truncated to MaxColumnWidth. Without ExplicitNulls, empty cells count as
+// null too. Null cells are kept as Null for FormatCSV to write as CSVNull.
+func (d *DiskTable) cell(i int, c string) string {
+	null := c == Null || (c == "" && !d.opts.ExplicitNulls)
+	switch {
+	case null && d.opts.Format == FormatCSV && d.opts.CSVNull != "":
+		return Null
+	case null:
+		c = nullPlaceholder(d.opts, i)
+	}
+	return truncate(c, d.opts.MaxColumnWidth, d.opts.TruncateUnit)
+}
+
+// measure widens the columns to fit cells.
+func (d *DiskTable) measure(cells []string) {
+	for i, c := range cells {
+		if d.opts.Format == FormatMarkdown {
+			c = markdownEscape(c)
+		}
+		w := measureWidth(c, d.opts)
+		if d.opts.Format == FormatMarkdown {
+			w = max(w, len(markdownSeparator(alignAt(d.aligns, i), 0)))
+		}
+		if i >= len(d.widths) {
+			d.widths = append(d.widths, w)
+		} else {
+			d.widths[i] = max(d.widths[i], w)
+		}
+	}
+}
+
+// writeHeader writes the lines above the first row.
+func (d *DiskTable) writeHeader(w io.Writer) {
+	switch d.opts.Format {
+	case FormatCSV:
+		var b strings.Builder
+		if d.opts.CSVDialect == CSVExcel {
+			b.WriteString("sep=" + string(csvDelimiter(d.opts)) + "\n")
+		}
+		if len(d.headers) > 0 && !d.opts.NoHeader {
+			writeCSVRecord(&b, d.opts, escapeFormulas(d.opts, d.headers), csvDelimiter(d.opts), nil)
+		}
+		io.WriteString(w, b.String())
+	case FormatPlain:
+		io.WriteString(w, d.rule("┌", "┬", "┐")+"\n")
+		if len(d.headers) > 0 && !d.opts.NoHeader {
+			d.writeLine(w, d.headers)
+			io.WriteString(w, d.rule("├", "┼", "┤")+"\n")
+		}
+	case FormatSimple:
+		if len(d.headers) > 0 && !d.opts.NoHeader {
+			d.writeLine(w, d.headers)
+			parts := make([]string, len(d.widths))
+			for i, n := range d.widths {
+				parts[i] = strings.Repeat("─", n)
+			}
+			io.WriteString(w, strings.Join(parts, "  ")+"\n")
+		}
+	case FormatMarkdown:
+		d.writeLine(w, d.headers)
+		seps := make([]string, len(d.widths))
+		for i, n := range d.widths {
+			seps[i] = markdownSeparator(alignAt(d.aligns, i), n)
+		}
+		io.WriteString(w, "| "+strings.Join(seps, " | ")+" |\n")
+	}
+}
+
+// writeLine writes one row or header line.
+func (d *DiskTable) writeLine(w io.Writer, cells []string) {
+	if d.opts.Format == FormatCSV {
+		nulls := make([]bool, len(cells))
+		for i, c := range cells {
+			nulls[i] = c == Null
+		}
+		var b strings.Builder
+		writeCSVRecord(&b, d.opts, escapeFormulas(d.opts, cells), csvDelimiter(d.opts), nulls)
+		io.WriteString(w, b.String())
+		return
+	}
+	if d.opts.Format == FormatMarkdown {
+		cells = mapCells(cells, markdownEscape)
+	}
+	io.WriteString(w, formatLine(d.opts.Format, cells, d.widths, d.aligns, d.opts)+"\n")
+}
+
+// rule returns a FormatPlain border line with the given corner and
+// junction characters.
+func (d *DiskTable) rule(left, mid, right string) string {
+	parts := make([]string, len(d.widths))
+	for i, n := range d.widths {
+		parts[i] = strings.Repeat("─", n+2)
+	}
+	return left + strings.Join(parts, mid) + right
+}
+
+// readDiskRow reads one row written by DiskTable.AddRow.
+func readDiskRow(r *bufio.Reader) ([]string, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	cells := make([]string, n)
+	for i := range cells {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, size)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		cells[i] = string(b)
+	}
+	return cells, nil
+}
+
+// countingWriter counts the bytes written through it and keeps the first
+// error.
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+// Write implements io.Writer.
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
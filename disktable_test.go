@@ -0,0 +1,151 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestDiskTable(t *testing.T) {
+	tests := []struct {
+		name string
+		opts tablewriter.Options
+		want string
+	}{
+		{
+			name: "plain",
+			opts: tablewriter.Options{Format: tablewriter.FormatPlain, Alignments: []tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignRight}},
+			want: "┌───────┬─────┐\n" +
+				"│ Name  │ Qty │\n" +
+				"├───────┼─────┤\n" +
+				"│ alice │   3 │\n" +
+				"│ bob   │ n/a │\n" +
+				"└───────┴─────┘\n",
+		},
+		{
+			name: "markdown",
+			opts: tablewriter.Options{Format: tablewriter.FormatMarkdown},
+			want: "| Name  | Qty |\n| ----- | --- |\n| alice | 3   |\n| bob   | n/a |\n",
+		},
+		{
+			name: "csv",
+			opts: tablewriter.Options{Format: tablewriter.FormatCSV},
+			want: "Name,Qty\nalice,3\nbob,n/a\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Headers = []string{"Name", "Qty"}
+			tt.opts.NullPlaceholder = "n/a"
+			dt, err := tablewriter.NewDiskTable(tt.opts, t.TempDir())
+			if err != nil {
+				t.Fatalf("NewDiskTable() error = %v", err)
+			}
+			defer dt.Close()
+			for _, r := range [][]string{{"alice", "3"}, {"bob", ""}} {
+				if err := dt.AddRow(r...); err != nil {
+					t.Fatalf("AddRow() error = %v", err)
+				}
+			}
+			var b strings.Builder
+			n, err := dt.WriteTo(&b)
+			if err != nil {
+				t.Fatalf("WriteTo() error = %v", err)
+			}
+			if b.String() != tt.want || n != int64(b.Len()) {
+				t.Errorf("WriteTo() = %d, %q, want %d, %q", n, b.String(), len(tt.want), tt.want)
+			}
+		})
+	}
+}
+
+func TestDiskTableRows(t *testing.T) {
+	dir := t.TempDir()
+	dt, err := tablewriter.NewDiskTable(tablewriter.Options{Format: tablewriter.FormatJSON}, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 1000; i++ {
+		if err := dt.AddRow(strings.Repeat("x", i%7), "ünïcode"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := dt.RowCount(); got != 1000 {
+		t.Errorf("RowCount() = %d, want 1000", got)
+	}
+	row, err := dt.Row(999)
+	if want := []string{"xxxxx", "ünïcode"}; err != nil || !reflect.DeepEqual(row, want) {
+		t.Errorf("Row(999) = %q, %v, want %q", row, err, want)
+	}
+	if _, err := dt.Row(1000); !errors.Is(err, tablewriter.ErrRowOutOfRange) {
+		t.Errorf("Row(1000) error = %v, want ErrRowOutOfRange", err)
+	}
+	if _, err := dt.WriteTo(&strings.Builder{}); !errors.Is(err, tablewriter.ErrStreamUnsupported) {
+		t.Errorf("WriteTo() error = %v, want ErrStreamUnsupported", err)
+	}
+	if err := dt.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Close() left %d files behind", len(entries))
+	}
+}
+
+func TestDiskTableMatchesTable(t *testing.T) {
+	center := []tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignCenter}
+	tests := []struct {
+		name string
+		opts tablewriter.Options
+	}{
+		{"csv null", tablewriter.Options{Format: tablewriter.FormatCSV, CSVNull: `\N`}},
+		{"csv explicit nulls", tablewriter.Options{Format: tablewriter.FormatCSV, CSVNull: `\N`, ExplicitNulls: true}},
+		{"explicit nulls", tablewriter.Options{Format: tablewriter.FormatPlain, NullPlaceholder: "-", ExplicitNulls: true}},
+		{"column null placeholders", tablewriter.Options{Format: tablewriter.FormatSimple, NullPlaceholders: []string{"", "?"}}},
+		{"markdown centered", tablewriter.Options{Format: tablewriter.FormatMarkdown, Alignments: center}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Headers = []string{"Name", "Q"}
+			rows := [][]string{{"a", ""}, {"b", tablewriter.Null}, {"c", "1"}}
+			want, err := tablewriter.Render(tt.opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			dt, err := tablewriter.NewDiskTable(tt.opts, t.TempDir())
+			if err != nil {
+				t.Fatalf("NewDiskTable() error = %v", err)
+			}
+			defer dt.Close()
+			for _, r := range rows {
+				if err := dt.AddRow(r...); err != nil {
+					t.Fatalf("AddRow() error = %v", err)
+				}
+			}
+			var b strings.Builder
+			if _, err := dt.WriteTo(&b); err != nil {
+				t.Fatalf("WriteTo() error = %v", err)
+			}
+			if b.String() != want {
+				t.Errorf("WriteTo() = %q, want %q", b.String(), want)
+			}
+		})
+	}
+}
+
+func TestDiskTableStrictColumnCount(t *testing.T) {
+	dt, err := tablewriter.NewDiskTable(tablewriter.Options{Headers: []string{"a", "b"}, StrictColumnCount: true}, t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDiskTable() error = %v", err)
+	}
+	defer dt.Close()
+	if err := dt.AddRow("1"); !errors.Is(err, tablewriter.ErrColumnMismatch) {
+		t.Errorf("AddRow() error = %v, want %v", err, tablewriter.ErrColumnMismatch)
+	}
+	if got := dt.RowCount(); got != 0 {
+		t.Errorf("RowCount() = %d, want 0", got)
+	}
+}
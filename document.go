@@ -0,0 +1,125 @@
+package tablewriter
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Document accumulates several titled tables and renders them as one output
+// with consistent spacing for the chosen format.
+type Document struct {
+	format   Format
+	sections []section
+}
+
+type section struct {
+	title string
+	table *Table
+}
+
+// NewDocument creates an empty Document. Every table added to it is rendered
+// in format f, overriding the table's own Format.
+//
+// Example:
+//
+//	doc := tablewriter.NewDocument(tablewriter.FormatMarkdown)
+//	doc.Add("Users", users)
+//	doc.Add("Groups", groups)
+//	out, err := doc.Render()
+func NewDocument(f Format) *Document {
+	return &Document{format: f}
+}
+
+// Add appends a table under the given title. An empty title omits the heading.
+//
+// Example:
+//
+//	doc.Add("Summary", summary)
+func (d *Document) Add(title string, t *Table) *Document {
+	d.sections = append(d.sections, section{title: title, table: t})
+	return d
+}
+
+// Render renders every table in order. Markdown uses "## Title" headings,
+// Plain and Simple underline the title, CSV separates sections with a blank
+// line and a "# Title" comment line, and JSON produces an object mapping each
+// title to its table's array (untitled tables are keyed "table_N").
+//
+// Example:
+//
+//	out, err := doc.Render()
+func (d *Document) Render() (string, error) {
+	if d.format == FormatJSON {
+		return d.renderJSON()
+	}
+	parts := make([]string, 0, len(d.sections))
+	for _, s := range d.sections {
+		out, err := d.renderTable(s.table)
+		if err != nil {
+			return "", err
+		}
+		out = strings.TrimSuffix(out, "\n")
+		if s.title != "" {
+			out = d.heading(s.title) + "\n" + out
+		}
+		parts = append(parts, out)
+	}
+	if len(parts) == 0 {
+		return "", nil
+	}
+	return strings.Join(parts, "\n\n") + "\n", nil
+}
+
+// renderTable renders t in the document's format.
+func (d *Document) renderTable(t *Table) (string, error) {
+	opts := t.opts
+	opts.Format = d.format
+	return t.renderAs(t.withWidthCache(opts, widthUseRender))
+}
+
+// heading formats a section title for the document's format.
+func (d *Document) heading(title string) string {
+	switch d.format {
+	case FormatMarkdown:
+		return "## " + title + "\n"
+	case FormatCSV:
+		return "# " + title
+	default:
+		return title + "\n" + strings.Repeat("=", displayWidth(title))
+	}
+}
+
+// renderJSON renders the document as one JSON object keyed by title,
+// preserving section order.
+func (d *Document) renderJSON() (string, error) {
+	keys := make([]string, len(d.sections))
+	for i, s := range d.sections {
+		keys[i] = s.title
+		if keys[i] == "" {
+			keys[i] = "table_" + strconv.Itoa(i+1)
+		}
+	}
+	keys, _ = dedupeHeaders(keys, DuplicateSuffix)
+
+	var b strings.Builder
+	b.WriteString("{")
+	for i, s := range d.sections {
+		out, err := d.renderTable(s.table)
+		if err != nil {
+			return "", err
+		}
+		k, err := json.Marshal(keys[i])
+		if err != nil {
+			return "", err
+		}
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.Write(k)
+		b.WriteString(":")
+		b.WriteString(strings.TrimSpace(out))
+	}
+	b.WriteString("}")
+	return b.String(), nil
+}
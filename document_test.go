@@ -0,0 +1,53 @@
+package tablewriter_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestDocument(t *testing.T) {
+	users := tablewriter.New(tablewriter.Options{Headers: []string{"Name"}})
+	users.AddRow("alice")
+	groups := tablewriter.New(tablewriter.Options{Headers: []string{"Group"}})
+	groups.AddRow("admins")
+
+	tests := []struct {
+		name   string
+		format tablewriter.Format
+		want   string
+	}{
+		{"markdown", tablewriter.FormatMarkdown, "## Users\n\n| Name  |\n| ----- |\n| alice |\n\n## Groups\n\n| Group  |\n| ------ |\n| admins |\n"},
+		{"csv", tablewriter.FormatCSV, "# Users\nName\nalice\n\n# Groups\nGroup\nadmins\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := tablewriter.NewDocument(tt.format).Add("Users", users).Add("Groups", groups).Render()
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+
+	t.Run("json", func(t *testing.T) {
+		out, err := tablewriter.NewDocument(tablewriter.FormatJSON).Add("Users", users).Add("Users", groups).Render()
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		var got map[string][]map[string]string
+		if err := json.Unmarshal([]byte(out), &got); err != nil {
+			t.Fatalf("Render() produced invalid JSON: %v\n%s", err, out)
+		}
+		if got["Users"][0]["Name"] != "alice" || got["Users_2"][0]["Group"] != "admins" {
+			t.Errorf("Render() = %s", out)
+		}
+		if !strings.HasPrefix(out, `{"Users":`) {
+			t.Errorf("Render() should keep section order: %s", out)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package tablewriter
+
+import (
+	"context"
+	"strings"
+)
+
+// renderEmpty renders a table with no data rows. Every format prints its
+// headers exactly as it would for a non-empty table; text formats then add
+// opts.EmptyMessage, centered under the header for Plain and Simple. HTML
+// output is an empty <tbody>.
+func renderEmpty(ctx context.Context, opts Options) (string, error) {
+	switch opts.Format {
+	case FormatJSON:
+		if len(opts.Headers) == 0 {
+			return "", ErrMissingHeaders
+		}
+		return "[]", nil
+	case FormatCSV, FormatHTML:
+		return renderFormat(ctx, opts, [][]string{})
+	case FormatList:
+		if opts.EmptyMessage == "" {
+			return "", nil
+		}
+		return opts.EmptyMessage + "\n", nil
+	}
+
+	out, err := renderFormat(ctx, opts, [][]string{})
+	if err != nil || opts.EmptyMessage == "" {
+		return out, err
+	}
+	out = strings.TrimSuffix(out, "\n")
+	if opts.Format == FormatMarkdown {
+		if out == "" {
+			return opts.EmptyMessage + "\n", nil
+		}
+		return out + "\n\n" + opts.EmptyMessage + "\n", nil
+	}
+
+	width := 0
+	for _, line := range strings.Split(out, "\n") {
+		if n := measureWidth(line, opts); n > width {
+			width = n
+		}
+	}
+	msg := alignMeasured(opts.EmptyMessage, measureWidth(opts.EmptyMessage, opts), width, AlignCenter)
+	msg = strings.TrimRight(msg, " ")
+	if out == "" {
+		return msg + "\n", nil
+	}
+	return out + "\n" + msg + "\n", nil
+}
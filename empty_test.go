@@ -0,0 +1,45 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestEmptyTable(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  tablewriter.Format
+		msg     string
+		want    []string
+		notWant string
+	}{
+		{"csv headers only", tablewriter.FormatCSV, "(no rows)", []string{"Name,Age\n"}, "(no rows)"},
+		{"json empty array", tablewriter.FormatJSON, "(no rows)", []string{"[]"}, "(no rows)"},
+		{"markdown message", tablewriter.FormatMarkdown, "(no rows)", []string{"| Name | Age |", "\n\n(no rows)\n"}, ""},
+		{"simple centered", tablewriter.FormatSimple, "(none)", []string{"Name", " (none)\n"}, ""},
+		{"simple no message", tablewriter.FormatSimple, "", []string{"Name"}, "("},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:      []string{"Name", "Age"},
+				Format:       tt.format,
+				EmptyMessage: tt.msg,
+			}
+			out, err := tablewriter.New(opts).RenderErr()
+			if err != nil {
+				t.Fatalf("RenderErr() error = %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("RenderErr() = %q, want it to contain %q", out, w)
+				}
+			}
+			if tt.notWant != "" && strings.Contains(out, tt.notWant) {
+				t.Errorf("RenderErr() = %q, should not contain %q", out, tt.notWant)
+			}
+		})
+	}
+}
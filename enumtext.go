@@ -0,0 +1,68 @@
+package tablewriter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// marshalEnum returns the name of v from names, for the MarshalText methods
+// of the option enums. typ names the enum in errors.
+func marshalEnum[T ~int](v T, names map[T]string, typ string) ([]byte, error) {
+	name, ok := names[v]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown %s %d", ErrInvalidOptions, typ, int(v))
+	}
+	return []byte(name), nil
+}
+
+// unmarshalEnum returns the value named text in names, ignoring case and
+// surrounding spaces.
+func unmarshalEnum[T ~int](text []byte, names map[T]string, typ string) (T, error) {
+	s := strings.ToLower(strings.TrimSpace(string(text)))
+	for v, name := range names {
+		if name == s {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: unknown %s %q", ErrInvalidOptions, typ, text)
+}
+
+// marshalFlags encodes the bit set v as the names of its flags joined by
+// "|", in ascending bit order, or as the name of 0 if no flag is set.
+func marshalFlags[T ~int](v T, names map[T]string, typ string) ([]byte, error) {
+	if v == 0 {
+		return []byte(names[0]), nil
+	}
+	flags := make([]T, 0, len(names))
+	for f := range names {
+		if f != 0 && v&f == f {
+			flags = append(flags, f)
+		}
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i] < flags[j] })
+	var rest T = v
+	parts := make([]string, len(flags))
+	for i, f := range flags {
+		parts[i] = names[f]
+		rest &^= f
+	}
+	if rest != 0 {
+		return nil, fmt.Errorf("%w: unknown %s %d", ErrInvalidOptions, typ, int(v))
+	}
+	return []byte(strings.Join(parts, "|")), nil
+}
+
+// unmarshalFlags parses the "|"-separated flag names written by
+// marshalFlags.
+func unmarshalFlags[T ~int](text []byte, names map[T]string, typ string) (T, error) {
+	var v T
+	for _, part := range strings.Split(string(text), "|") {
+		f, err := unmarshalEnum([]byte(part), names, typ)
+		if err != nil {
+			return 0, err
+		}
+		v |= f
+	}
+	return v, nil
+}
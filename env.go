@@ -0,0 +1,66 @@
+package tablewriter
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Environment variables read by FromEnv.
+const (
+	// EnvNoColor disables ANSI styling when set to any non-empty value,
+	// following https://no-color.org.
+	EnvNoColor = "NO_COLOR"
+
+	// EnvFormat selects the default format by name, e.g. "markdown".
+	EnvFormat = "TABLEWRITER_FORMAT"
+
+	// EnvColumns is the terminal width used to fit tables.
+	EnvColumns = "COLUMNS"
+)
+
+// FromEnv returns DefaultOptions adjusted by the environment, so every CLI
+// built on this package follows the same conventions: NO_COLOR sets NoColor,
+// TABLEWRITER_FORMAT sets Format (see ParseFormat), and COLUMNS sets
+// MaxTableWidth. Unset variables leave the defaults alone; an unknown
+// format name is an error.
+//
+// Example:
+//
+//	opts, err := tablewriter.FromEnv()
+//	if err != nil {
+//	    return err
+//	}
+//	t := tablewriter.New(opts.WithHeaders("Name", "Status"))
+func FromEnv() (Options, error) {
+	opts := DefaultOptions()
+	if os.Getenv(EnvNoColor) != "" {
+		opts.NoColor = true
+	}
+	if name := os.Getenv(EnvFormat); name != "" {
+		f, err := ParseFormat(name)
+		if err != nil {
+			return opts, fmt.Errorf("%s: %w", EnvFormat, err)
+		}
+		opts.Format = f
+	}
+	opts.MaxTableWidth = terminalWidth()
+	return opts, nil
+}
+
+// stripANSI removes ANSI CSI escape sequences from s.
+func stripANSI(s string) string {
+	if !strings.Contains(s, "\x1b[") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if end := ansiSeqEnd(s, i); end > i {
+			i = end
+			continue
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
@@ -0,0 +1,58 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	t.Setenv("TABLEWRITER_FORMAT", "markdown")
+	t.Setenv("COLUMNS", "100")
+	opts, err := tablewriter.FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() error = %v", err)
+	}
+	if !opts.NoColor || opts.Format != tablewriter.FormatMarkdown || opts.MaxTableWidth != 100 {
+		t.Errorf("FromEnv() = NoColor %v, Format %v, MaxTableWidth %d, want true, markdown, 100",
+			opts.NoColor, opts.Format, opts.MaxTableWidth)
+	}
+}
+
+func TestFromEnvUnset(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("TABLEWRITER_FORMAT", "")
+	t.Setenv("COLUMNS", "")
+	opts, err := tablewriter.FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() error = %v", err)
+	}
+	if opts.NoColor || opts.Format != tablewriter.FormatPlain || opts.MaxTableWidth != 0 {
+		t.Errorf("FromEnv() = %+v, want defaults", opts)
+	}
+}
+
+func TestFromEnvInvalidFormat(t *testing.T) {
+	t.Setenv("TABLEWRITER_FORMAT", "xml")
+	if _, err := tablewriter.FromEnv(); !errors.Is(err, tablewriter.ErrInvalidFormat) {
+		t.Errorf("FromEnv() error = %v, want %v", err, tablewriter.ErrInvalidFormat)
+	}
+}
+
+func TestNoColor(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers:    []string{"Health"},
+		Format:     tablewriter.FormatSimple,
+		StyleRules: map[string][]tablewriter.StyleRule{"Health": tablewriter.StatusRules(tablewriter.DefaultStatusStyles)},
+	}.WithNoColor()
+	out, err := tablewriter.Render(opts, [][]string{{"ok"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if strings.Contains(out, "\x1b[") {
+		t.Errorf("Render() = %q, want no escape sequences", out)
+	}
+}
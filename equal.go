@@ -0,0 +1,143 @@
+package tablewriter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiffKind classifies a Difference between two tables.
+type DiffKind int
+
+const (
+	DiffHeader     DiffKind = iota // DiffHeader is a header that differs.
+	DiffCell                       // DiffCell is a cell that differs between rows at the same index.
+	DiffMissingRow                 // DiffMissingRow is a row of the first table absent from the second.
+	DiffExtraRow                   // DiffExtraRow is a row of the second table absent from the first.
+)
+
+// Difference is one way in which two tables differ.
+type Difference struct {
+	Kind DiffKind
+
+	// Row is the row index: in the first table for DiffCell and
+	// DiffMissingRow, in the second for DiffExtraRow. -1 for DiffHeader.
+	Row int
+
+	// Column is the column index for DiffHeader and DiffCell, -1 otherwise.
+	Column int
+
+	// A and B are the differing header or cell values of each table.
+	A, B string
+
+	// Cells is the row for DiffMissingRow and DiffExtraRow.
+	Cells []string
+}
+
+// String describes d, e.g. `row 2, column 1: "ok" != "error"`.
+func (d Difference) String() string {
+	switch d.Kind {
+	case DiffHeader:
+		return fmt.Sprintf("header %d: %q != %q", d.Column, d.A, d.B)
+	case DiffCell:
+		return fmt.Sprintf("row %d, column %d: %q != %q", d.Row, d.Column, d.A, d.B)
+	case DiffMissingRow:
+		return fmt.Sprintf("row %d missing: %q", d.Row, d.Cells)
+	default:
+		return fmt.Sprintf("row %d extra: %q", d.Row, d.Cells)
+	}
+}
+
+// Equal reports whether a and b have the same headers and the same rows in
+// the same order, and lists the differences: headers, then cells of rows
+// at the same index, then rows beyond the shorter table. Display options
+// are ignored, and a missing trailing cell equals an empty one.
+//
+// Example:
+//
+//	if ok, diffs := tablewriter.Equal(want, got); !ok {
+//	    for _, d := range diffs {
+//	        t.Error(d)
+//	    }
+//	}
+func Equal(a, b *Table) (bool, []Difference) {
+	diffs := headerDiffs(a, b)
+	for r := 0; r < max(len(a.rows), len(b.rows)); r++ {
+		switch {
+		case r >= len(b.rows):
+			diffs = append(diffs, Difference{Kind: DiffMissingRow, Row: r, Column: -1, Cells: cloneCells(a.rows[r])})
+		case r >= len(a.rows):
+			diffs = append(diffs, Difference{Kind: DiffExtraRow, Row: r, Column: -1, Cells: cloneCells(b.rows[r])})
+		default:
+			ra, rb := a.rows[r], b.rows[r]
+			for c := 0; c < max(len(ra), len(rb)); c++ {
+				if va, vb := cellAt(ra, c), cellAt(rb, c); va != vb {
+					diffs = append(diffs, Difference{Kind: DiffCell, Row: r, Column: c, A: va, B: vb})
+				}
+			}
+		}
+	}
+	return len(diffs) == 0, diffs
+}
+
+// EqualUnordered is like Equal but ignores row order: rows are matched as a
+// multiset, and the differences are header differences followed by the
+// rows of a with no match in b and the rows of b with no match in a.
+//
+// Example:
+//
+//	ok, diffs := tablewriter.EqualUnordered(expected, loadedFromDB)
+func EqualUnordered(a, b *Table) (bool, []Difference) {
+	diffs := headerDiffs(a, b)
+	unmatched := make(map[string][]int, len(b.rows))
+	for i, r := range b.rows {
+		k := rowKey(r)
+		unmatched[k] = append(unmatched[k], i)
+	}
+	for i, r := range a.rows {
+		k := rowKey(r)
+		if idx := unmatched[k]; len(idx) > 0 {
+			unmatched[k] = idx[1:]
+			continue
+		}
+		diffs = append(diffs, Difference{Kind: DiffMissingRow, Row: i, Column: -1, Cells: cloneCells(r)})
+	}
+	for i, r := range b.rows {
+		k := rowKey(r)
+		if idx := unmatched[k]; len(idx) > 0 && idx[0] == i {
+			unmatched[k] = idx[1:]
+			diffs = append(diffs, Difference{Kind: DiffExtraRow, Row: i, Column: -1, Cells: cloneCells(r)})
+		}
+	}
+	return len(diffs) == 0, diffs
+}
+
+// headerDiffs returns the header differences between a and b.
+func headerDiffs(a, b *Table) []Difference {
+	ha, hb := resolveColumns(a.opts).Headers, resolveColumns(b.opts).Headers
+	var diffs []Difference
+	for c := 0; c < max(len(ha), len(hb)); c++ {
+		if va, vb := cellAt(ha, c), cellAt(hb, c); va != vb {
+			diffs = append(diffs, Difference{Kind: DiffHeader, Row: -1, Column: c, A: va, B: vb})
+		}
+	}
+	return diffs
+}
+
+// rowKey returns a map key identifying r's cells, ignoring trailing empty
+// cells.
+func rowKey(r []string) string {
+	n := len(r)
+	for n > 0 && r[n-1] == "" {
+		n--
+	}
+	var b strings.Builder
+	for _, c := range r[:n] {
+		fmt.Fprintf(&b, "%d:%s", len(c), c)
+	}
+	return b.String()
+}
+
+// cloneCells returns a copy of cells.
+func cloneCells(cells []string) []string {
+	return append([]string(nil), cells...)
+}
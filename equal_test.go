@@ -0,0 +1,58 @@
+package tablewriter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func newTable(t *testing.T, headers []string, rows ...[]string) *tablewriter.Table {
+	t.Helper()
+	tbl := tablewriter.New(tablewriter.Options{Headers: headers})
+	if err := tbl.AddRows(rows); err != nil {
+		t.Fatalf("AddRows() error = %v", err)
+	}
+	return tbl
+}
+
+func TestEqual(t *testing.T) {
+	a := newTable(t, []string{"ID", "Status"}, []string{"1", "ok"}, []string{"2", "ok"}, []string{"3", "ok"})
+	b := newTable(t, []string{"ID", "State"}, []string{"1", "ok"}, []string{"2", "error", ""})
+	ok, diffs := tablewriter.Equal(a, b)
+	if ok {
+		t.Fatal("Equal() = true, want false")
+	}
+	want := []tablewriter.Difference{
+		{Kind: tablewriter.DiffHeader, Row: -1, Column: 1, A: "Status", B: "State"},
+		{Kind: tablewriter.DiffCell, Row: 1, Column: 1, A: "ok", B: "error"},
+		{Kind: tablewriter.DiffMissingRow, Row: 2, Column: -1, Cells: []string{"3", "ok"}},
+	}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("Equal() diffs = %v, want %v", diffs, want)
+	}
+	if ok, diffs := tablewriter.Equal(a, a); !ok || diffs != nil {
+		t.Errorf("Equal(a, a) = %v, %v, want true, nil", ok, diffs)
+	}
+}
+
+func TestEqualUnordered(t *testing.T) {
+	a := newTable(t, []string{"ID"}, []string{"1"}, []string{"2"}, []string{"2"})
+	b := newTable(t, []string{"ID"}, []string{"2"}, []string{"1", ""}, []string{"3"})
+	ok, diffs := tablewriter.EqualUnordered(a, b)
+	if ok {
+		t.Fatal("EqualUnordered() = true, want false")
+	}
+	want := []string{`row 2 missing: ["2"]`, `row 2 extra: ["3"]`}
+	var got []string
+	for _, d := range diffs {
+		got = append(got, d.String())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EqualUnordered() diffs = %q, want %q", got, want)
+	}
+	shuffled := newTable(t, []string{"ID"}, []string{"2"}, []string{"1"}, []string{"2"})
+	if ok, _ := tablewriter.EqualUnordered(a, shuffled); !ok {
+		t.Error("EqualUnordered() = false for the same rows in another order")
+	}
+}
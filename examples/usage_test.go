@@ -1,35 +1,42 @@
-package main
-
-// Package examples contains examples demonstrating the usage of the tablewriter library.
+// Package examples_test contains examples demonstrating the usage of the tablewriter library.
 package examples_test
 
 import (
-	"context"
 	"fmt"
 	"log"
 
 	"github.com/njchilds90/go-tablewriter"
 )
 
-// ExampleNewTableWriter demonstrates how to create a new TableWriter instance.
-func ExampleNewTableWriter() {
-	// Create a new table writer with default settings.
-	tw := tablewriter.NewTableWriter()
-	// Output: 
+// ExampleNew demonstrates building a table row by row.
+func ExampleNew() {
+	t := tablewriter.New(tablewriter.Options{
+		Headers: []string{"Name", "Age"},
+		Format:  tablewriter.FormatMarkdown,
+	})
+	if err := t.AddRows([][]string{{"John", "25"}, {"Alice", "30"}}); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(t.Render())
+	// Output:
+	// | Name  | Age |
+	// | ----- | --- |
+	// | John  | 25  |
+	// | Alice | 30  |
 }
 
-// ExampleTableWriterWrite demonstrates how to write data to a TableWriter instance.
-func ExampleTableWriterWrite() {
-	tw := tablewriter.NewTableWriter()
-	data := [][]string{
-		{"Name", "Age"},
-		{"John", "25"},
-		{"Alice", "30"},
-	}
-	// Write the data to the table writer.
-	err := tw.Write(context.Background(), data)
+// ExampleRender demonstrates rendering rows in one call.
+func ExampleRender() {
+	out, err := tablewriter.Render(
+		tablewriter.Options{Headers: []string{"Name", "Age"}, Format: tablewriter.FormatCSV},
+		[][]string{{"John", "25"}, {"Alice", "30"}},
+	)
 	if err != nil {
 		log.Fatal(err)
 	}
-	// Output: 
+	fmt.Print(out)
+	// Output:
+	// Name,Age
+	// John,25
+	// Alice,30
 }
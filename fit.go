@@ -0,0 +1,105 @@
+package tablewriter
+
+import (
+	"context"
+	"sort"
+)
+
+// DefaultMinColumnWidth is the narrowest a column shrinks to under
+// MaxTableWidth when MinColumnWidths has no entry for it.
+const DefaultMinColumnWidth = 4
+
+// fitTableWidth truncates headers and cells so the table fits within
+// opts.MaxTableWidth, shrinking columns in proportion to how far each is
+// above its minimum width.
+func fitTableWidth(ctx context.Context, opts Options, rows [][]string) (Options, [][]string, error) {
+	widths, err := colWidths(ctx, opts, rows)
+	if err != nil {
+		return opts, nil, err
+	}
+	borders := tableWidth(opts.Format, make([]int, len(widths)))
+	target := shrinkWidths(widths, minWidths(opts, widths), opts.MaxTableWidth-borders)
+	opts = truncateColumns(opts, rows, target)
+	return opts, rows, nil
+}
+
+// minWidths returns the minimum width of each column, never more than its
+// natural width.
+func minWidths(opts Options, widths []int) []int {
+	mins := make([]int, len(widths))
+	for i, w := range widths {
+		m := DefaultMinColumnWidth
+		if i < len(opts.MinColumnWidths) && opts.MinColumnWidths[i] > 0 {
+			m = opts.MinColumnWidths[i]
+		}
+		mins[i] = min(m, w)
+	}
+	return mins
+}
+
+// shrinkWidths reduces widths so they sum to at most budget, taking from each
+// column in proportion to its surplus over mins. Columns never go below their
+// minimum, so the result may still exceed budget.
+func shrinkWidths(widths, mins []int, budget int) []int {
+	out := append([]int(nil), widths...)
+	total, surplus := 0, 0
+	for i, w := range widths {
+		total += w
+		surplus += w - mins[i]
+	}
+	excess := total - budget
+	if excess <= 0 || surplus == 0 {
+		return out
+	}
+	if excess >= surplus {
+		copy(out, mins)
+		return out
+	}
+
+	cut := 0
+	for i, w := range widths {
+		d := excess * (w - mins[i]) / surplus
+		out[i] -= d
+		cut += d
+	}
+	// Hand out the rounding remainder one unit at a time, largest surplus first.
+	order := make([]int, len(widths))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return out[order[a]]-mins[order[a]] > out[order[b]]-mins[order[b]]
+	})
+	for j := 0; cut < excess; j = (j + 1) % len(order) {
+		if i := order[j]; out[i] > mins[i] {
+			out[i]--
+			cut++
+		}
+	}
+	return out
+}
+
+// truncateColumns truncates each header and cell to the display width of
+// its column, whatever opts.TruncateUnit is, since widths are columns.
+// rows is modified in place; the updated Options are returned.
+func truncateColumns(opts Options, rows [][]string, widths []int) Options {
+	opts.Headers = append([]string(nil), opts.Headers...)
+	for i, h := range opts.Headers {
+		if i < len(widths) {
+			opts.Headers[i] = truncate(h, widths[i], TruncateDisplayWidth)
+		}
+	}
+	for i, r := range rows {
+		for j, c := range r {
+			if j < len(widths) {
+				if c == "" {
+					c = opts.NullPlaceholder
+				}
+				if r[j] = truncate(c, widths[j], TruncateDisplayWidth); r[j] != c {
+					opts.cuts.cutAt(i, j)
+				}
+			}
+		}
+	}
+	return opts
+}
@@ -0,0 +1,48 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestMaxTableWidth(t *testing.T) {
+	headers := []string{"ID", "Title", "Description"}
+	rows := [][]string{{"1", "A fairly long title", "An even longer description of the item"}}
+	tests := []struct {
+		name     string
+		maxWidth int
+		mins     []int
+		want     []string
+	}{
+		{"fits", 200, nil, []string{"An even longer description of the item"}},
+		{"proportional", 50, nil, []string{"| 1   |", "A fairly l...", "An even longer descri..."}},
+		{"minimums", 30, []int{0, 10}, []string{"A fairly...", "An ..."}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:         headers,
+				Format:          tablewriter.FormatMarkdown,
+				MaxTableWidth:   tt.maxWidth,
+				MinColumnWidths: tt.mins,
+			}
+			out, err := tablewriter.Render(opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("Render() should contain %q:\n%s", w, out)
+				}
+			}
+			for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+				if n := utf8.RuneCountInString(line); n > tt.maxWidth && !strings.Contains(line, "---") {
+					t.Errorf("line %q is %d wide, want <= %d", line, n, tt.maxWidth)
+				}
+			}
+		})
+	}
+}
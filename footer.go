@@ -0,0 +1,138 @@
+package tablewriter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// FooterSeparator selects the line drawn above footer rows.
+type FooterSeparator int
+
+const (
+	FooterRule       FooterSeparator = iota // FooterRule draws a single rule, ├───┼───┤ (default).
+	FooterDoubleRule                        // FooterDoubleRule draws a double rule, ╞═══╪═══╡.
+	FooterHeavyRule                         // FooterHeavyRule draws a heavy rule, ┝━━━┿━━━┥.
+	FooterBlankLine                         // FooterBlankLine leaves an empty row.
+)
+
+// footerRuleChars lists the left edge, fill, junction, and right edge of
+// each footer separator in FormatPlain. FormatSimple uses only the fill.
+var footerRuleChars = map[FooterSeparator][4]string{
+	FooterRule:       {"├", "─", "┼", "┤"},
+	FooterDoubleRule: {"╞", "═", "╪", "╡"},
+	FooterHeavyRule:  {"┝", "━", "┿", "┥"},
+	FooterBlankLine:  {"│", " ", "│", "│"},
+}
+
+// AddFooter appends a footer row, such as totals, drawn after the data rows.
+//
+// Example:
+//
+//	t.AddFooter("Total", "1,234")
+func (t *Table) AddFooter(cols ...string) {
+	t.opts.Footers = append(t.opts.Footers, append([]string(nil), cols...))
+	t.widths.reset()
+}
+
+// renderFooters renders rows followed by opts.Footers, with the
+// FooterSeparator line between them. Layouts that reflow rows (WrapCells,
+// WidthPercentile, WrapHeaders, ResponsiveWidth, SplitWidth) and Markdown
+// show the footers as trailing rows without a separator.
+func renderFooters(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	all := append(cloneRows(rows), cloneRows(opts.Footers)...)
+	if !footerRuleFits(opts) {
+		return renderLayout(ctx, opts, all)
+	}
+	opts, all, err := sizeColumns(ctx, opts, all)
+	if err != nil {
+		return "", err
+	}
+	widths, err := colWidths(ctx, opts, all)
+	if err != nil {
+		return "", err
+	}
+	out, err := renderFormat(ctx, opts, all)
+	if err != nil {
+		return "", err
+	}
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	at := len(lines) - len(opts.Footers)
+	if opts.Format == FormatPlain {
+		at-- // bottom border
+	}
+	if at < 0 {
+		return out, nil
+	}
+	lines = append(lines[:at], append([]string{footerRule(opts, widths)}, lines[at:]...)...)
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// footerRuleFits reports whether footers are drawn below a separator line:
+// the format has row separators and every footer stays on one line.
+func footerRuleFits(opts Options) bool {
+	return opts.Format != FormatMarkdown && !opts.WrapCells && opts.WidthPercentile == 0 &&
+		!opts.WrapHeaders && opts.ResponsiveWidth == 0 && opts.SplitWidth == 0
+}
+
+// footerRule returns the separator line above the footers for columns of
+// the given widths.
+func footerRule(opts Options, widths []int) string {
+	chars := footerRuleChars[opts.FooterSeparator]
+	if opts.Format == FormatSimple {
+		if opts.FooterSeparator == FooterBlankLine {
+			return ""
+		}
+		parts := make([]string, len(widths))
+		for i, w := range widths {
+			parts[i] = strings.Repeat(chars[1], w)
+		}
+		return strings.Join(parts, "  ")
+	}
+	parts := make([]string, len(widths))
+	for i, w := range widths {
+		parts[i] = strings.Repeat(chars[1], w+2)
+	}
+	return chars[0] + strings.Join(parts, chars[2]) + chars[3]
+}
+
+// footerSeparatorNames maps each FooterSeparator to its name in configuration files.
+var footerSeparatorNames = map[FooterSeparator]string{
+	FooterRule:       "rule",
+	FooterDoubleRule: "double-rule",
+	FooterHeavyRule:  "heavy-rule",
+	FooterBlankLine:  "blank-line",
+}
+
+// MarshalText encodes s by name, e.g. "double-rule", implementing
+// encoding.TextMarshaler.
+func (s FooterSeparator) MarshalText() ([]byte, error) {
+	return marshalEnum(s, footerSeparatorNames, "FooterSeparator")
+}
+
+// UnmarshalText parses a name written by MarshalText, ignoring case,
+// implementing encoding.TextUnmarshaler.
+func (s *FooterSeparator) UnmarshalText(text []byte) error {
+	v, err := unmarshalEnum(text, footerSeparatorNames, "FooterSeparator")
+	if err != nil {
+		return err
+	}
+	*s = v
+	return nil
+}
+
+// WithFooters returns a copy of Options with footer rows, such as totals,
+// drawn after the data rows below a sep line. Returns ErrInvalidOptions if
+// sep is not a known separator.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithFooters(tablewriter.FooterDoubleRule, []string{"Total", "1,234"})
+func (o Options) WithFooters(sep FooterSeparator, rows ...[]string) (Options, error) {
+	if _, ok := footerSeparatorNames[sep]; !ok {
+		return o, fmt.Errorf("invalid footer separator %d: %w", int(sep), ErrInvalidOptions)
+	}
+	o.Footers = rows
+	o.FooterSeparator = sep
+	return o, nil
+}
@@ -0,0 +1,100 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestFooterSeparator(t *testing.T) {
+	tests := []struct {
+		name string
+		sep  tablewriter.FooterSeparator
+		want string
+	}{
+		{"rule", tablewriter.FooterRule, "─────  ──\n"},
+		{"double", tablewriter.FooterDoubleRule, "═════  ══\n"},
+		{"heavy", tablewriter.FooterHeavyRule, "━━━━━  ━━\n"},
+		{"blank", tablewriter.FooterBlankLine, "\n\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:         []string{"Item", "N"},
+				Format:          tablewriter.FormatSimple,
+				Footers:         [][]string{{"Total", "12"}},
+				FooterSeparator: tt.sep,
+			}
+			got, err := tablewriter.Render(opts, [][]string{{"a", "5"}, {"b", "7"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+			if len(lines) < 2 || !strings.HasPrefix(lines[len(lines)-1], "Total") {
+				t.Fatalf("Render() = %q, want footer last", got)
+			}
+			if sep := lines[len(lines)-2] + "\n"; strings.TrimRight(sep, " \n") != strings.TrimRight(tt.want, "\n") {
+				t.Errorf("separator = %q, want %q", sep, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddFooterMarkdown(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Item", "N"}, Format: tablewriter.FormatMarkdown, SortBy: "Item"})
+	tbl.AddRow("b", "7")
+	tbl.AddRow("a", "5")
+	tbl.AddFooter("Total", "12")
+	got, err := tbl.RenderErr()
+	if err != nil {
+		t.Fatalf("RenderErr() error = %v", err)
+	}
+	if !strings.HasSuffix(got, "| b     | 7   |\n| Total | 12  |\n") {
+		t.Errorf("RenderErr() = %q, want sorted rows then footer", got)
+	}
+}
+
+func TestFootersDroppedColumns(t *testing.T) {
+	tests := []struct {
+		name string
+		opts tablewriter.Options
+	}{
+		{"hidden", tablewriter.Options{Columns: []tablewriter.Column{{Name: "Item"}, {Name: "Note", Hidden: true}, {Name: "N"}}}},
+		{"column filter", tablewriter.Options{Headers: []string{"Item", "Note", "N"}, ColumnFilter: []string{"Item", "N"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			opts.Format = tablewriter.FormatSimple
+			opts.Footers = [][]string{{"Total", "-", "12"}}
+			got, err := tablewriter.Render(opts, [][]string{{"a", "x", "5"}, {"b", "y", "7"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.HasSuffix(got, "Total  12\n") || strings.Contains(got, "-") {
+				t.Errorf("Render() = %q, want the footer without the dropped column", got)
+			}
+		})
+	}
+}
+
+func TestWithFooters(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithFooters(tablewriter.FooterSeparator(9)); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithFooters(9) error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+	opts, err := tablewriter.DefaultOptions().WithFooters(tablewriter.FooterHeavyRule, []string{"Total", "12"})
+	if err != nil {
+		t.Fatalf("WithFooters() error = %v", err)
+	}
+	opts = opts.WithHeaders("Item", "N")
+	opts.Format = tablewriter.FormatSimple
+	got, err := tablewriter.Render(opts, [][]string{{"a", "12"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.HasSuffix(got, "━━━━━  ━━\nTotal  12\n") {
+		t.Errorf("Render() = %q, want a heavy rule then the footer", got)
+	}
+}
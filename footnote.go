@@ -0,0 +1,134 @@
+package tablewriter
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// HeaderRow is the Footnote.Row value that refers to the header row.
+const HeaderRow = -1
+
+// Footnote attaches a note to one cell, or to a header when Row is HeaderRow.
+type Footnote struct {
+	// Row is the data row index, or HeaderRow for the header.
+	Row int
+
+	// Column is the column index. Notes on columns that are not rendered,
+	// such as Hidden columns, are dropped.
+	Column int
+
+	// Text is the note shown beneath the table.
+	Text string
+}
+
+// AddFootnote attaches a note to the cell at row, col, where row is the
+// index of the row as added. The marker follows the row through filtering,
+// sorting, paging, and sampling, and is dropped with it if the row is not
+// rendered. Notes with identical text share a marker.
+//
+// Example:
+//
+//	t.AddFootnote(2, 1, "estimated value")
+func (t *Table) AddFootnote(row, col int, text string) {
+	t.opts.Footnotes = append(t.opts.Footnotes, Footnote{Row: row, Column: col, Text: text})
+	t.widths.reset()
+}
+
+// AddHeaderFootnote attaches a note to the header of column col.
+//
+// Example:
+//
+//	t.AddHeaderFootnote(3, "in thousands of USD")
+func (t *Table) AddHeaderFootnote(col int, text string) {
+	t.AddFootnote(HeaderRow, col, text)
+}
+
+// resolveFootnotes returns fns with each data row index, which refers to
+// the table's rows, replaced by the position of that row in src, the
+// source indexes of the rows being rendered. Notes on rows that are not
+// rendered are dropped.
+func resolveFootnotes(fns []Footnote, src []int) []Footnote {
+	pos := make(map[int]int, len(src))
+	for i, j := range src {
+		pos[j] = i
+	}
+	out := make([]Footnote, 0, len(fns))
+	for _, fn := range fns {
+		if fn.Row != HeaderRow {
+			i, ok := pos[fn.Row]
+			if !ok {
+				continue
+			}
+			fn.Row = i
+		}
+		out = append(out, fn)
+	}
+	return out
+}
+
+// renderFootnotes appends superscript markers to annotated cells, renders
+// the table, and lists the notes beneath it in order of first use.
+func renderFootnotes(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	cellLimit := opts.MaxColumnWidth
+	if opts.WrapCells {
+		cellLimit = 0
+	}
+	numbers := map[string]int{}
+	var notes []string
+	opts.Headers = append([]string(nil), opts.Headers...)
+	for _, fn := range opts.Footnotes {
+		n, ok := numbers[fn.Text]
+		if !ok {
+			notes = append(notes, fn.Text)
+			n = len(notes)
+			numbers[fn.Text] = n
+		}
+		marker := superscript(n)
+		switch {
+		case fn.Row == HeaderRow && fn.Column >= 0 && fn.Column < len(opts.Headers):
+			opts.Headers[fn.Column] = markCell(opts.Headers[fn.Column], marker, headerLimit(opts), opts.TruncateUnit)
+		case fn.Row >= 0 && fn.Row < len(rows) && fn.Column >= 0 && fn.Column < len(rows[fn.Row]):
+			rows[fn.Row][fn.Column] = markCell(rows[fn.Row][fn.Column], marker, cellLimit, opts.TruncateUnit)
+		}
+	}
+
+	out, err := renderTable(ctx, opts, rows)
+	if err != nil {
+		return "", err
+	}
+	lines := make([]string, len(notes))
+	for i, note := range notes {
+		lines[i] = superscript(i+1) + " " + note
+	}
+	sep := "\n"
+	if opts.Format == FormatMarkdown {
+		sep = "\n\n"
+	}
+	return strings.TrimSuffix(out, "\n") + "\n\n" + strings.Join(lines, sep) + "\n", nil
+}
+
+// markCell appends marker to v, first truncating v to leave room for the
+// marker within limit so that truncating the cell never cuts the marker off.
+func markCell(v, marker string, limit int, unit TruncateUnit) string {
+	if limit > 0 {
+		size, n := unit.measure(), 0
+		for _, r := range marker {
+			n += size(r)
+		}
+		v = truncate(v, max(limit-n, 1), unit)
+	}
+	return v + marker
+}
+
+// superscriptDigits maps '0'..'9' to their Unicode superscript forms.
+var superscriptDigits = []rune("⁰¹²³⁴⁵⁶⁷⁸⁹")
+
+// superscript formats n using Unicode superscript digits.
+func superscript(n int) string {
+	var b strings.Builder
+	for _, d := range strconv.Itoa(n) {
+		b.WriteRune(superscriptDigits[d-'0'])
+	}
+	return b.String()
+}
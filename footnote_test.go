@@ -0,0 +1,147 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestFootnotes(t *testing.T) {
+	tests := []struct {
+		name   string
+		format tablewriter.Format
+		want   []string
+	}{
+		{"markdown", tablewriter.FormatMarkdown, []string{"| Revenue¹ |", "| 120²     |", "| 95²      |", "\n\n¹ in thousands\n\n² estimated\n"}},
+		{"simple", tablewriter.FormatSimple, []string{"Revenue¹", "120²", "\n\n¹ in thousands\n² estimated\n"}},
+		{"csv ignores notes", tablewriter.FormatCSV, []string{"Revenue\n120\n95\n"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Revenue"}, Format: tt.format})
+			tbl.AddRows([][]string{{"120"}, {"95"}})
+			tbl.AddHeaderFootnote(0, "in thousands")
+			tbl.AddFootnote(0, 0, "estimated")
+			tbl.AddFootnote(1, 0, "estimated")
+			out, err := tbl.RenderErr()
+			if err != nil {
+				t.Fatalf("RenderErr() error = %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("RenderErr() = %q, want it to contain %q", out, w)
+				}
+			}
+		})
+	}
+}
+
+func TestFootnotesFollowRows(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   tablewriter.Options
+		sample bool
+		want   string
+	}{
+		{
+			name: "sorted",
+			opts: tablewriter.Options{SortBy: "Name"},
+			want: "a¹\nb \nc ",
+		},
+		{
+			name: "filtered",
+			opts: tablewriter.Options{RowFilter: func(row []string) bool { return row[0] != "c" }},
+			want: "b \na¹",
+		},
+		{
+			name: "filtered out",
+			opts: tablewriter.Options{RowFilter: func(row []string) bool { return row[0] != "a" }},
+			want: "c\nb",
+		},
+		{
+			name: "offset",
+			opts: tablewriter.Options{Offset: 1},
+			want: "b \na¹",
+		},
+		{
+			name:   "sampled",
+			sample: true,
+			want:   "c \na¹",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			opts.Headers = []string{"Name"}
+			opts.Format = tablewriter.FormatSimple
+			opts.NoHeader = true
+			tbl := tablewriter.New(opts)
+			tbl.AddRows([][]string{{"c"}, {"b"}, {"a"}})
+			tbl.AddFootnote(2, 0, "checked")
+			var out string
+			var err error
+			if tt.sample {
+				out, err = tbl.Sample(2, 3)
+			} else {
+				out, err = tbl.RenderErr()
+			}
+			if err != nil {
+				t.Fatalf("render error = %v", err)
+			}
+			if body, _, _ := strings.Cut(strings.TrimSuffix(out, "\n"), "\n\n"); body != tt.want {
+				t.Errorf("render = %q, want rows %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestFootnotesHTML(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Name"}, Format: tablewriter.FormatHTML})
+	tbl.AddRow("a")
+	tbl.AddFootnote(0, 0, "checked")
+	if _, err := tbl.RenderErr(); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("RenderErr() error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+}
+
+func TestFootnotesColumns(t *testing.T) {
+	tests := []struct {
+		name string
+		opts tablewriter.Options
+		want string
+	}{
+		{
+			name: "hidden column",
+			opts: tablewriter.Options{Columns: []tablewriter.Column{{Name: "ID", Hidden: true}, {Name: "Name"}, {Name: "Note"}}},
+			want: "Name  Note     \n────  ─────────\n      abcdefgh¹\n\n¹ checked\n",
+		},
+		{
+			name: "omitted empty column",
+			opts: tablewriter.Options{Headers: []string{"Name", "Gap", "Note"}, OmitEmptyColumns: true},
+			want: "Name   Note     \n─────  ─────────\nalpha  abcdefgh¹\n\n¹ checked\n",
+		},
+		{
+			name: "truncated cell",
+			opts: tablewriter.Options{Headers: []string{"Name", "Gap", "Note"}, MaxColumnWidth: 6},
+			want: "Name   Gap  Note  \n─────  ───  ──────\nalpha       ab...¹\n\n¹ checked\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			opts.Format = tablewriter.FormatSimple
+			tbl := tablewriter.New(opts)
+			tbl.AddRow("alpha", "", "abcdefgh")
+			tbl.AddFootnote(0, 2, "checked")
+			out, err := tbl.RenderErr()
+			if err != nil {
+				t.Fatalf("RenderErr() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("RenderErr() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
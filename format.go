@@ -0,0 +1,108 @@
+package tablewriter
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Formatter converts a raw cell value into its display text.
+type Formatter func(string) string
+
+// applyFormatters runs each column's formatter over its cells in place,
+// skipping Null cells.
+func applyFormatters(formatters []Formatter, rows [][]string) {
+	for _, r := range rows {
+		for i, c := range r {
+			if i < len(formatters) && formatters[i] != nil && c != Null {
+				r[i] = formatters[i](c)
+			}
+		}
+	}
+}
+
+// BoolFormatter returns a Formatter that renders true-like values ("true",
+// "yes", "y", "on", "1") as trueSym and false-like values ("false", "no",
+// "n", "off", "0") as falseSym, case-insensitively. Other values, including
+// empty cells, are left unchanged.
+//
+// Example:
+//
+//	opts := tablewriter.Options{
+//	    Headers:    []string{"Feature", "Free", "Pro"},
+//	    Formatters: []tablewriter.Formatter{nil, tablewriter.BoolCheck, tablewriter.BoolCheck},
+//	}
+func BoolFormatter(trueSym, falseSym string) Formatter {
+	return func(v string) string {
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "true", "yes", "y", "on", "1":
+			return trueSym
+		case "false", "no", "n", "off", "0":
+			return falseSym
+		default:
+			return v
+		}
+	}
+}
+
+var (
+	// BoolCheck renders booleans as ✓ and ✗.
+	BoolCheck = BoolFormatter("✓", "✗")
+
+	// BoolDot renders booleans as ● and ○.
+	BoolDot = BoolFormatter("●", "○")
+
+	// BoolYesNo renders booleans as "yes" and "no".
+	BoolYesNo = BoolFormatter("yes", "no")
+)
+
+// BytesFormatter returns a Formatter that renders raw byte counts in human
+// units: binary (KiB, MiB, GiB, ... powers of 1024) or decimal (kB, MB,
+// GB, ... powers of 1000). Values under 10 units keep one decimal place, so
+// output looks like "1.4 GiB" or "203 MB". Non-numeric cells are unchanged.
+//
+// Example:
+//
+//	f := tablewriter.BytesFormatter(true)
+//	f("1503238553") // "1.4 GiB"
+func BytesFormatter(binary bool) Formatter {
+	base, units := 1000.0, []string{"B", "kB", "MB", "GB", "TB", "PB", "EB"}
+	if binary {
+		base, units = 1024.0, []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	}
+	return func(v string) string {
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return v
+		}
+		sign := ""
+		if n < 0 {
+			sign, n = "-", -n
+		}
+		i := 0
+		for n >= base && i < len(units)-1 {
+			n /= base
+			i++
+		}
+		switch {
+		case i == 0:
+			return sign + strconv.FormatFloat(n, 'f', -1, 64) + " " + units[0]
+		case n < 10:
+			return sign + strconv.FormatFloat(n, 'f', 1, 64) + " " + units[i]
+		default:
+			return sign + strconv.FormatFloat(n, 'f', 0, 64) + " " + units[i]
+		}
+	}
+}
+
+// BytesColumn returns a right-aligned Column that formats byte counts with
+// BytesFormatter.
+//
+// Example:
+//
+//	opts := tablewriter.Options{Columns: []tablewriter.Column{
+//	    {Name: "File"},
+//	    tablewriter.BytesColumn("Size", true),
+//	}}
+func BytesColumn(name string, binary bool) Column {
+	return Column{Name: name, Alignment: AlignRight, Formatter: BytesFormatter(binary)}
+}
@@ -0,0 +1,84 @@
+package tablewriter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestBoolFormatter(t *testing.T) {
+	tests := []struct {
+		name string
+		f    tablewriter.Formatter
+		in   string
+		want string
+	}{
+		{"true", tablewriter.BoolCheck, "true", "✓"},
+		{"yes", tablewriter.BoolCheck, "Yes", "✓"},
+		{"one", tablewriter.BoolDot, "1", "●"},
+		{"false", tablewriter.BoolCheck, "FALSE", "✗"},
+		{"zero", tablewriter.BoolDot, "0", "○"},
+		{"no", tablewriter.BoolYesNo, "n", "no"},
+		{"unknown", tablewriter.BoolCheck, "maybe", "maybe"},
+		{"empty", tablewriter.BoolCheck, "", ""},
+		{"custom", tablewriter.BoolFormatter("on", "off"), "yes", "on"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f(tt.in); got != tt.want {
+				t.Errorf("formatter(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBytesFormatter(t *testing.T) {
+	tests := []struct {
+		name   string
+		binary bool
+		in     string
+		want   string
+	}{
+		{"bytes", true, "512", "512 B"},
+		{"binary kib", true, "1536", "1.5 KiB"},
+		{"binary gib", true, "1503238553", "1.4 GiB"},
+		{"decimal mb", false, "203000000", "203 MB"},
+		{"decimal kb", false, "1000", "1.0 kB"},
+		{"negative", false, "-2500", "-2.5 kB"},
+		{"not a number", true, "n/a", "n/a"},
+		{"empty", true, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tablewriter.BytesFormatter(tt.binary)(tt.in); got != tt.want {
+				t.Errorf("BytesFormatter(%v)(%q) = %q, want %q", tt.binary, tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelativeTimeFormatter(t *testing.T) {
+	now := func() time.Time { return time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name   string
+		layout string
+		in     string
+		want   string
+	}{
+		{"hours ago", "", "2024-06-01T09:00:00Z", "3h ago"},
+		{"days in future", "", "2024-06-03T13:00:00Z", "in 2d"},
+		{"seconds", "", "2024-06-01T11:59:15Z", "45s ago"},
+		{"now", "", "2024-06-01T12:00:00Z", "now"},
+		{"years", "", "2021-01-01T00:00:00Z", "3y ago"},
+		{"custom layout", "2006-01-02", "2024-05-25", "7d ago"},
+		{"unparseable", "", "yesterday", "yesterday"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tablewriter.RelativeTimeFormatter(now, tt.layout)(tt.in); got != tt.want {
+				t.Errorf("RelativeTimeFormatter()(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package tablewriter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// formatNames maps each Format to its canonical name.
+var formatNames = map[Format]string{
+	FormatPlain:    "plain",
+	FormatMarkdown: "markdown",
+	FormatCSV:      "csv",
+	FormatJSON:     "json",
+	FormatSimple:   "simple",
+	FormatList:     "list",
+	FormatHTML:     "html",
+}
+
+// formatAliases maps alternative names accepted by ParseFormat.
+var formatAliases = map[string]Format{
+	"md":   FormatMarkdown,
+	"text": FormatPlain,
+}
+
+// String returns the canonical name of f, e.g. "markdown", as accepted by
+// ParseFormat.
+func (f Format) String() string {
+	if name, ok := formatNames[f]; ok {
+		return name
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// ParseFormat returns the Format named s, ignoring case and surrounding
+// spaces. It accepts the names returned by Format.String plus "md" and
+// "text".
+//
+// Example:
+//
+//	f, err := tablewriter.ParseFormat("markdown") // tablewriter.FormatMarkdown
+func ParseFormat(s string) (Format, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	for f, n := range formatNames {
+		if n == name {
+			return f, nil
+		}
+	}
+	if f, ok := formatAliases[name]; ok {
+		return f, nil
+	}
+	return FormatPlain, fmt.Errorf("%w: %q (want plain, markdown, csv, json, simple, list, or html)", ErrInvalidFormat, s)
+}
+
+// Set parses s with ParseFormat, so that *Format implements flag.Value.
+//
+// Example:
+//
+//	format := tablewriter.FormatPlain
+//	flag.Var(&format, "format", "output format: plain, markdown, csv, json, simple, list, or html")
+func (f *Format) Set(s string) error {
+	v, err := ParseFormat(s)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalText encodes f by name, implementing encoding.TextMarshaler so that
+// Options serialize with "markdown" rather than 1.
+func (f Format) MarshalText() ([]byte, error) {
+	name, ok := formatNames[f]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidFormat, int(f))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText parses text with ParseFormat, implementing
+// encoding.TextUnmarshaler for configuration files and environment decoders.
+func (f *Format) UnmarshalText(text []byte) error {
+	return f.Set(string(text))
+}
@@ -0,0 +1,55 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    tablewriter.Format
+		wantErr error
+	}{
+		{"markdown", tablewriter.FormatMarkdown, nil},
+		{" CSV ", tablewriter.FormatCSV, nil},
+		{"md", tablewriter.FormatMarkdown, nil},
+		{"json", tablewriter.FormatJSON, nil},
+		{"xml", tablewriter.FormatPlain, tablewriter.ErrInvalidFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := tablewriter.ParseFormat(tt.in)
+			if !errors.Is(err, tt.wantErr) || got != tt.want {
+				t.Errorf("ParseFormat(%q) = %v, %v, want %v, %v", tt.in, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFormatString(t *testing.T) {
+	for _, f := range []tablewriter.Format{tablewriter.FormatPlain, tablewriter.FormatMarkdown, tablewriter.FormatCSV, tablewriter.FormatJSON, tablewriter.FormatSimple, tablewriter.FormatList, tablewriter.FormatHTML} {
+		got, err := tablewriter.ParseFormat(f.String())
+		if err != nil || got != f {
+			t.Errorf("ParseFormat(%q) = %v, %v, want %v", f.String(), got, err, f)
+		}
+	}
+	if got := tablewriter.Format(99).String(); got != "Format(99)" {
+		t.Errorf("Format(99).String() = %q, want %q", got, "Format(99)")
+	}
+}
+
+func TestFormatFlag(t *testing.T) {
+	format := tablewriter.FormatPlain
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&format, "format", "output format")
+	if err := fs.Parse([]string{"--format", "simple"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if format != tablewriter.FormatSimple {
+		t.Errorf("format = %v, want %v", format, tablewriter.FormatSimple)
+	}
+}
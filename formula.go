@@ -0,0 +1,28 @@
+package tablewriter
+
+// formulaTriggers are the leading characters spreadsheet applications
+// interpret as the start of a formula.
+const formulaTriggers = "=+-@\t\r"
+
+// escapeFormula prefixes v with a single quote if it would otherwise be
+// evaluated as a formula when opened in a spreadsheet.
+func escapeFormula(v string) string {
+	if v == "" {
+		return v
+	}
+	for _, c := range formulaTriggers {
+		if rune(v[0]) == c {
+			return "'" + v
+		}
+	}
+	return v
+}
+
+// escapeFormulas returns cells with escapeFormula applied if
+// opts.EscapeFormulas is set, and cells unchanged otherwise.
+func escapeFormulas(opts Options, cells []string) []string {
+	if !opts.EscapeFormulas {
+		return cells
+	}
+	return mapCells(cells, escapeFormula)
+}
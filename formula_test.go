@@ -0,0 +1,87 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestEscapeFormulas(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  tablewriter.Format
+		escape  bool
+		cell    string
+		wantOut string
+	}{
+		{"equals", tablewriter.FormatCSV, true, "=SUM(A1:A2)", "'=SUM(A1:A2)\n"},
+		{"plus", tablewriter.FormatCSV, true, "+1", "'+1\n"},
+		{"minus", tablewriter.FormatCSV, true, "-2", "'-2\n"},
+		{"at", tablewriter.FormatCSV, true, "@cmd", "'@cmd\n"},
+		{"plain text", tablewriter.FormatCSV, true, "hello", "hello\n"},
+		{"disabled", tablewriter.FormatCSV, false, "=1+1", "=1+1\n"},
+		{"not csv", tablewriter.FormatMarkdown, true, "=1+1", "| =1+1 |"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Format: tt.format, EscapeFormulas: tt.escape}
+			out, err := tablewriter.Render(opts, [][]string{{tt.cell}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.Contains(out, tt.wantOut) {
+				t.Errorf("Render() = %q, want it to contain %q", out, tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestEscapeFormulasDialects(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect tablewriter.CSVDialect
+		want    string
+	}{
+		{"standard", tablewriter.CSVStandard, "'=H\n'=1+1\n'@cmd\n"},
+		{"excel", tablewriter.CSVExcel, "sep=,\n'=H\n'=1+1\n'@cmd\n"},
+		{"sheets tsv", tablewriter.CSVSheetsTSV, "'=H\n'=1+1\n'@cmd\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:        []string{"=H"},
+				Format:         tablewriter.FormatCSV,
+				CSVDialect:     tt.dialect,
+				CSVDelimiter:   ',',
+				EscapeFormulas: true,
+			}
+			rows := [][]string{{"=1+1"}, {"@cmd"}}
+			out, err := tablewriter.Render(opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() = %q, want %q", out, tt.want)
+			}
+
+			dt, err := tablewriter.NewDiskTable(opts, t.TempDir())
+			if err != nil {
+				t.Fatalf("NewDiskTable() error = %v", err)
+			}
+			defer dt.Close()
+			for _, r := range rows {
+				if err := dt.AddRow(r...); err != nil {
+					t.Fatalf("AddRow() error = %v", err)
+				}
+			}
+			var b strings.Builder
+			if _, err := dt.WriteTo(&b); err != nil {
+				t.Fatalf("WriteTo() error = %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("DiskTable.WriteTo() = %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package tablewriter
+
+import (
+	"go/format"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// GoOptions configures RenderGo.
+type GoOptions struct {
+	// Var is the name of the declared variable. Defaults to "rows".
+	Var string
+
+	// Struct declares a slice of anonymous structs, one field per column,
+	// instead of a [][]string. Field names are the headers converted to
+	// exported Go identifiers, e.g. "first name" becomes FirstName. Int,
+	// Float, and Bool columns (declared or inferred) become int64, float64,
+	// and bool fields; other columns are strings.
+	Struct bool
+}
+
+// RenderGo renders rows as gofmt-formatted Go source declaring them as a
+// variable, after the same render-time processing as Render, for pasting
+// real output into table-driven tests. As a [][]string the headers, if
+// any, precede the literal as a comment; as structs (see GoOptions.Struct)
+// headers are required.
+//
+// Example:
+//
+//	src, err := tablewriter.RenderGo(opts, rows, tablewriter.GoOptions{Var: "want", Struct: true})
+//	// var want = []struct {
+//	//     Name string
+//	//     Age  int64
+//	// }{
+//	//     {Name: "alice", Age: 30},
+//	// }
+func RenderGo(opts Options, rows [][]string, goOpts GoOptions) (string, error) {
+	return renderGo(opts, rows, nil, goOpts)
+}
+
+// RenderGo renders the table as a Go variable declaration; see the
+// package-level RenderGo.
+//
+// Example:
+//
+//	src, err := t.RenderGo(tablewriter.GoOptions{Var: "fixture"})
+func (t *Table) RenderGo(goOpts GoOptions) (string, error) {
+	return renderGo(t.opts, t.rows, t.meta, goOpts)
+}
+
+// renderGo prepares rows and writes the Go declaration for them.
+func renderGo(opts Options, rows [][]string, meta []RowMeta, goOpts GoOptions) (string, error) {
+	// Headers are identifiers, as in JSON: no display labels, and repeated
+	// names are deduplicated.
+	opts.Format = FormatJSON
+	opts, rows, err := prepare(opts, rows, meta)
+	if err != nil {
+		return "", err
+	}
+	name := goOpts.Var
+	if name == "" {
+		name = "rows"
+	}
+
+	var b strings.Builder
+	if goOpts.Struct {
+		if len(opts.Headers) == 0 {
+			return "", ErrMissingHeaders
+		}
+		writeGoStructs(&b, name, opts.Headers, sqlColumnTypes(opts, rows), rows)
+	} else {
+		if len(opts.Headers) > 0 {
+			b.WriteString("// " + strings.Join(mapCells(opts.Headers, flattenLines), ", ") + "\n")
+		}
+		b.WriteString("var " + name + " = [][]string{\n")
+		for _, r := range rows {
+			vals := make([]string, len(r))
+			for i, c := range r {
+				vals[i] = strconv.Quote(c)
+			}
+			b.WriteString("{" + strings.Join(vals, ", ") + "},\n")
+		}
+		b.WriteString("}\n")
+	}
+	src, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return "", err
+	}
+	return string(src), nil
+}
+
+// writeGoStructs writes a declaration of rows as a slice of anonymous
+// structs.
+func writeGoStructs(b *strings.Builder, name string, headers []string, types []ColumnType, rows [][]string) {
+	fields := goFieldNames(headers)
+	kinds := make([]string, len(headers))
+	for i, t := range types {
+		kinds[i] = "string"
+		switch {
+		case t == TypeInt && goLiterals(rows, i, t):
+			kinds[i] = "int64"
+		case t == TypeFloat && goLiterals(rows, i, t):
+			kinds[i] = "float64"
+		case t == TypeBool && goLiterals(rows, i, t):
+			kinds[i] = "bool"
+		}
+	}
+	b.WriteString("var " + name + " = []struct {\n")
+	for i, f := range fields {
+		b.WriteString(f + " " + kinds[i] + "\n")
+	}
+	b.WriteString("}{\n")
+	for _, r := range rows {
+		var vals []string
+		for i, f := range fields {
+			v := cellAt(r, i)
+			if kinds[i] != "string" {
+				if strings.TrimSpace(v) == "" {
+					continue // zero value
+				}
+				pv, _ := types[i].parse(v)
+				switch x := pv.(type) {
+				case int64:
+					v = strconv.FormatInt(x, 10)
+				case float64:
+					v = strconv.FormatFloat(x, 'g', -1, 64)
+				case bool:
+					v = strconv.FormatBool(x)
+				}
+			} else {
+				v = strconv.Quote(v)
+			}
+			vals = append(vals, f+": "+v)
+		}
+		b.WriteString("{" + strings.Join(vals, ", ") + "},\n")
+	}
+	b.WriteString("}\n")
+}
+
+// goLiterals reports whether every non-empty cell of column col parses as
+// type t and, for floats, is finite, so it can be written as a Go literal.
+func goLiterals(rows [][]string, col int, t ColumnType) bool {
+	for _, r := range rows {
+		v := cellAt(r, col)
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+		pv, ok := t.parse(v)
+		if !ok {
+			return false
+		}
+		if f, isFloat := pv.(float64); isFloat && (math.IsInf(f, 0) || math.IsNaN(f)) {
+			return false
+		}
+	}
+	return true
+}
+
+// goFieldNames converts headers to distinct exported Go identifiers: words
+// are capitalized and joined, other characters dropped, and a name that
+// would be empty or start with a digit gets a "Col" prefix.
+func goFieldNames(headers []string) []string {
+	names := make([]string, len(headers))
+	seen := map[string]int{}
+	for i, h := range headers {
+		var b strings.Builder
+		upper := true
+		for _, r := range h {
+			switch {
+			case unicode.IsLetter(r) || unicode.IsDigit(r):
+				if upper {
+					r = unicode.ToUpper(r)
+				}
+				b.WriteRune(r)
+				upper = false
+			default:
+				upper = true
+			}
+		}
+		name := b.String()
+		if name == "" || unicode.IsDigit([]rune(name)[0]) {
+			name = "Col" + name
+		}
+		if name == "Col" {
+			name += strconv.Itoa(i + 1)
+		}
+		seen[name]++
+		if n := seen[name]; n > 1 {
+			name += strconv.Itoa(n)
+		}
+		names[i] = name
+	}
+	return names
+}
+
+// flattenLines replaces line breaks in s with spaces, so s fits in a line
+// comment.
+func flattenLines(s string) string {
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(s)
+}
@@ -0,0 +1,60 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRenderGo(t *testing.T) {
+	opts := tablewriter.Options{Headers: []string{"name", "age", "score", "2nd choice", "Name"}}
+	rows := [][]string{{"O\"Brien", "30", "1.5", "tab\there", "x"}, {"bob", "", "2", "", "y"}}
+	tests := []struct {
+		name   string
+		goOpts tablewriter.GoOptions
+		want   string
+	}{
+		{
+			"strings",
+			tablewriter.GoOptions{},
+			"// name, age, score, 2nd choice, Name\n" +
+				"var rows = [][]string{\n" +
+				"\t{\"O\\\"Brien\", \"30\", \"1.5\", \"tab\\there\", \"x\"},\n" +
+				"\t{\"bob\", \"\", \"2\", \"\", \"y\"},\n" +
+				"}\n",
+		},
+		{
+			"structs",
+			tablewriter.GoOptions{Var: "want", Struct: true},
+			"var want = []struct {\n" +
+				"\tName         string\n" +
+				"\tAge          int64\n" +
+				"\tScore        float64\n" +
+				"\tCol2ndChoice string\n" +
+				"\tName2        string\n" +
+				"}{\n" +
+				"\t{Name: \"O\\\"Brien\", Age: 30, Score: 1.5, Col2ndChoice: \"tab\\there\", Name2: \"x\"},\n" +
+				"\t{Name: \"bob\", Score: 2, Col2ndChoice: \"\", Name2: \"y\"},\n" +
+				"}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tablewriter.RenderGo(opts, rows, tt.goOpts)
+			if err != nil {
+				t.Fatalf("RenderGo() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RenderGo() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderGoStructWithoutHeaders(t *testing.T) {
+	_, err := tablewriter.RenderGo(tablewriter.Options{}, [][]string{{"a"}}, tablewriter.GoOptions{Struct: true})
+	if !errors.Is(err, tablewriter.ErrMissingHeaders) {
+		t.Errorf("RenderGo() error = %v, want ErrMissingHeaders", err)
+	}
+}
@@ -0,0 +1,50 @@
+package tablewriter
+
+import "strings"
+
+// RenderGrid renders the table and returns it as a grid of runes, one slice
+// per output line, which lets TUI frameworks paint, clip, and scroll the
+// table region by region. The grid is indexed by rune, not by terminal
+// cell: lines are padded with spaces to the same display width, so a line
+// holding wide East Asian characters, which take two cells each, has fewer
+// runes than one without, and grid[y][x] is in column x only on lines with
+// no wide characters. ANSI styling is stripped; the grid carries text only.
+//
+// Example:
+//
+//	grid, err := t.RenderGrid()
+//	for y, line := range grid {
+//	    x := 0
+//	    for _, r := range line {
+//	        screen.SetContent(x, y, r, nil, style)
+//	        x += runewidth.RuneWidth(r)
+//	    }
+//	}
+func (t *Table) RenderGrid() ([][]rune, error) {
+	out, err := t.RenderErr()
+	if err != nil {
+		return nil, err
+	}
+	return toGrid(out), nil
+}
+
+// toGrid splits s into lines, strips ANSI styling, and pads each line to
+// the display width of the widest.
+func toGrid(s string) [][]rune {
+	s = strings.TrimSuffix(stripANSI(s), "\n")
+	if s == "" {
+		return [][]rune{}
+	}
+	lines := strings.Split(s, "\n")
+	widths := make([]int, len(lines))
+	width := 0
+	for i, l := range lines {
+		widths[i] = displayWidth(l)
+		width = max(width, widths[i])
+	}
+	grid := make([][]rune, len(lines))
+	for i, l := range lines {
+		grid[i] = []rune(alignMeasured(l, widths[i], width, AlignLeft))
+	}
+	return grid
+}
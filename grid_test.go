@@ -0,0 +1,48 @@
+package tablewriter_test
+
+import (
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRenderGrid(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]string
+		want []string
+	}{
+		{"pads short lines", [][]string{{"alpha", "1"}, {"b", "2"}}, []string{"Name,N ", "alpha,1", "b,2    "}},
+		{"strips styling", [][]string{{"\x1b[31mred\x1b[0m", "1"}}, []string{"Name,N", "red,1 "}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Name", "N"}, Format: tablewriter.FormatCSV})
+			if err := tbl.AddRows(tt.rows); err != nil {
+				t.Fatalf("AddRows() error = %v", err)
+			}
+			grid, err := tbl.RenderGrid()
+			if err != nil {
+				t.Fatalf("RenderGrid() error = %v", err)
+			}
+			if len(grid) != len(tt.want) {
+				t.Fatalf("RenderGrid() = %d lines, want %d", len(grid), len(tt.want))
+			}
+			for i, line := range grid {
+				if string(line) != tt.want[i] {
+					t.Errorf("line %d = %q, want %q", i, string(line), tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRenderGridEmpty(t *testing.T) {
+	grid, err := tablewriter.New(tablewriter.Options{Format: tablewriter.FormatCSV}).RenderGrid()
+	if err != nil {
+		t.Fatalf("RenderGrid() error = %v", err)
+	}
+	if len(grid) != 0 {
+		t.Errorf("RenderGrid() = %q, want no lines", grid)
+	}
+}
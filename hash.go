@@ -0,0 +1,38 @@
+package tablewriter
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// Hash returns a stable hex-encoded SHA-256 digest of the table's headers
+// and rows, independent of format and display options, so a change-detection
+// job can tell whether regenerated data differs before re-publishing it.
+// Tables with the same headers and the same rows in the same order have the
+// same hash; cell boundaries are part of the digest, so {"ab", "c"} and
+// {"a", "bc"} differ.
+//
+// Example:
+//
+//	if t.Hash() == lastPublished {
+//	    return nil // nothing changed
+//	}
+func (t *Table) Hash() string {
+	h := sha256.New()
+	var n [8]byte
+	write := func(cells []string) {
+		binary.BigEndian.PutUint64(n[:], uint64(len(cells)))
+		h.Write(n[:])
+		for _, c := range cells {
+			binary.BigEndian.PutUint64(n[:], uint64(len(c)))
+			h.Write(n[:])
+			h.Write([]byte(c))
+		}
+	}
+	write(resolveColumns(t.opts).Headers)
+	for _, r := range t.rows {
+		write(r)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
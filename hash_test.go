@@ -0,0 +1,39 @@
+package tablewriter_test
+
+import (
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestHash(t *testing.T) {
+	build := func(opts tablewriter.Options, rows ...[]string) string {
+		tbl := tablewriter.New(opts)
+		if err := tbl.AddRows(rows); err != nil {
+			t.Fatalf("AddRows() error = %v", err)
+		}
+		return tbl.Hash()
+	}
+	base := build(tablewriter.Options{Headers: []string{"A", "B"}}, []string{"ab", "c"})
+	tests := []struct {
+		name string
+		hash string
+		same bool
+	}{
+		{"same data", build(tablewriter.Options{Headers: []string{"A", "B"}}, []string{"ab", "c"}), true},
+		{"other format", build(tablewriter.Options{Headers: []string{"A", "B"}, Format: tablewriter.FormatJSON}, []string{"ab", "c"}), true},
+		{"cell boundary", build(tablewriter.Options{Headers: []string{"A", "B"}}, []string{"a", "bc"}), false},
+		{"header", build(tablewriter.Options{Headers: []string{"A", "C"}}, []string{"ab", "c"}), false},
+		{"extra row", build(tablewriter.Options{Headers: []string{"A", "B"}}, []string{"ab", "c"}, []string{}), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if (tt.hash == base) != tt.same {
+				t.Errorf("Hash() = %s, base %s, want same = %v", tt.hash, base, tt.same)
+			}
+		})
+	}
+	if len(base) != 64 {
+		t.Errorf("Hash() = %q, want 64 hex digits", base)
+	}
+}
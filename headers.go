@@ -0,0 +1,136 @@
+package tablewriter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// dedupeHeaders returns headers with repeated names made unique according to
+// mode. The first occurrence keeps its name; later ones get "_2", "_3", ...
+// suffixes, skipping any suffixed name that is already taken.
+func dedupeHeaders(headers []string, mode DuplicateHeaderMode) ([]string, error) {
+	taken := make(map[string]bool, len(headers))
+	for _, h := range headers {
+		taken[h] = true
+	}
+	if len(taken) == len(headers) {
+		return headers, nil
+	}
+
+	out := make([]string, len(headers))
+	seen := make(map[string]bool, len(headers))
+	for i, h := range headers {
+		if !seen[h] {
+			seen[h] = true
+			out[i] = h
+			continue
+		}
+		if mode == DuplicateError {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateHeader, h)
+		}
+		for n := 2; ; n++ {
+			name := h + "_" + strconv.Itoa(n)
+			if !taken[name] {
+				taken[name] = true
+				out[i] = name
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+// headerLabel returns the display label for header key h.
+func headerLabel(h string, opts Options) string {
+	if l, ok := opts.HeaderLabels[h]; ok {
+		return l
+	}
+	if opts.HeaderCase != nil {
+		return opts.HeaderCase(h)
+	}
+	return h
+}
+
+// splitWords splits an identifier such as "created_at", "createdAt",
+// "HTTPStatus", or "user-id" into its words.
+func splitWords(s string) []string {
+	var words []string
+	var cur []rune
+	runes := []rune(s)
+	flush := func() {
+		if len(cur) > 0 {
+			words = append(words, string(cur))
+			cur = nil
+		}
+	}
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || unicode.IsSpace(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && len(cur) > 0:
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+		cur = append(cur, r)
+	}
+	flush()
+	return words
+}
+
+// TitleCase converts an identifier to space-separated title case:
+// "created_at" and "createdAt" become "Created At", "HTTPStatus" becomes
+// "HTTP Status". Use it as Options.HeaderCase.
+//
+// Example:
+//
+//	opts := tablewriter.Options{HeaderCase: tablewriter.TitleCase}
+func TitleCase(s string) string {
+	words := splitWords(s)
+	for i, w := range words {
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		words[i] = string(r)
+	}
+	return strings.Join(words, " ")
+}
+
+// SentenceCase converts an identifier to space-separated words with only
+// the first capitalized: "created_at" becomes "Created at".
+//
+// Example:
+//
+//	opts := tablewriter.Options{HeaderCase: tablewriter.SentenceCase}
+func SentenceCase(s string) string {
+	words := splitWords(s)
+	for i, w := range words {
+		r := []rune(w)
+		if i == 0 {
+			r[0] = unicode.ToUpper(r[0])
+		} else if !isAcronym(w) {
+			r = []rune(strings.ToLower(w))
+		}
+		words[i] = string(r)
+	}
+	return strings.Join(words, " ")
+}
+
+// UpperCase converts an identifier to space-separated upper case, in the
+// style of kubectl: "created_at" becomes "CREATED AT".
+//
+// Example:
+//
+//	opts := tablewriter.Options{HeaderCase: tablewriter.UpperCase}
+func UpperCase(s string) string {
+	return strings.ToUpper(strings.Join(splitWords(s), " "))
+}
+
+// isAcronym reports whether w has more than one letter and is all upper case.
+func isAcronym(w string) bool {
+	return len([]rune(w)) > 1 && strings.ToUpper(w) == w
+}
@@ -0,0 +1,118 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestDuplicateHeadersJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		mode    tablewriter.DuplicateHeaderMode
+		want    []string
+		wantErr error
+	}{{
+		"suffix",
+		[]string{"id", "name", "name", "name"},
+		tablewriter.DuplicateSuffix,
+		[]string{`"name": "a"`, `"name_2": "b"`, `"name_3": "c"`},
+		nil,
+	}, {
+		"suffix skips taken name",
+		[]string{"x", "x", "x_2"},
+		tablewriter.DuplicateSuffix,
+		[]string{`"x": "1"`, `"x_3": "2"`, `"x_2": "3"`},
+		nil,
+	}, {
+		"error",
+		[]string{"id", "id"},
+		tablewriter.DuplicateError,
+		nil,
+		tablewriter.ErrDuplicateHeader,
+	}}
+	rows := map[string][][]string{
+		"suffix":                  {{"1", "a", "b", "c"}},
+		"suffix skips taken name": {{"1", "2", "3"}},
+		"error":                   {{"1", "2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:          tt.headers,
+				Format:           tablewriter.FormatJSON,
+				DuplicateHeaders: tt.mode,
+			}
+			out, err := tablewriter.Render(opts, rows[tt.name])
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Render() error = %v, want %v", err, tt.wantErr)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("Render() = %s, want it to contain %s", out, w)
+				}
+			}
+		})
+	}
+}
+
+func TestHeaderLabels(t *testing.T) {
+	labels := map[string]string{"created_at": "Created", "id": "ID"}
+	tests := []struct {
+		name   string
+		format tablewriter.Format
+		want   string
+	}{
+		{"csv", tablewriter.FormatCSV, "ID,Created,owner\n"},
+		{"markdown", tablewriter.FormatMarkdown, "| ID  | Created    | owner |"},
+		{"json keeps keys", tablewriter.FormatJSON, `"created_at": "2024-01-01"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:      []string{"id", "created_at", "owner"},
+				HeaderLabels: labels,
+				Format:       tt.format,
+			}
+			out, err := tablewriter.Render(opts, [][]string{{"1", "2024-01-01", "ops"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("Render() = %q, want it to contain %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestHeaderCase(t *testing.T) {
+	tests := []struct {
+		in       string
+		title    string
+		sentence string
+		upper    string
+	}{
+		{"created_at", "Created At", "Created at", "CREATED AT"},
+		{"createdAt", "Created At", "Created at", "CREATED AT"},
+		{"HTTPStatus", "HTTP Status", "HTTP status", "HTTP STATUS"},
+		{"user-id", "User Id", "User id", "USER ID"},
+		{"ipv4Addr", "Ipv4 Addr", "Ipv4 addr", "IPV4 ADDR"},
+		{"ID", "ID", "ID", "ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := tablewriter.TitleCase(tt.in); got != tt.title {
+				t.Errorf("TitleCase(%q) = %q, want %q", tt.in, got, tt.title)
+			}
+			if got := tablewriter.SentenceCase(tt.in); got != tt.sentence {
+				t.Errorf("SentenceCase(%q) = %q, want %q", tt.in, got, tt.sentence)
+			}
+			if got := tablewriter.UpperCase(tt.in); got != tt.upper {
+				t.Errorf("UpperCase(%q) = %q, want %q", tt.in, got, tt.upper)
+			}
+		})
+	}
+}
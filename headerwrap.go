@@ -0,0 +1,107 @@
+package tablewriter
+
+import (
+	"context"
+	"strings"
+)
+
+// renderWrappedHeaders wraps each header to the width of its column's data
+// (or its longest word, if wider). In FormatMarkdown the lines are joined
+// with "<br>"; in other text formats the table is rendered with the first
+// header line and the remaining lines are inserted beneath it. Units, if
+// any, form the last header line.
+func renderWrappedHeaders(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	dataOpts := opts
+	dataOpts.Headers = nil
+	widths, err := colWidths(ctx, dataOpts, rows)
+	if err != nil {
+		return "", err
+	}
+
+	wrapped := make([][]string, len(opts.Headers))
+	height := 1
+	for i, h := range opts.Headers {
+		w := longestWord(h)
+		if i < len(widths) {
+			w = max(w, widths[i])
+		}
+		wrapped[i] = wrapText(h, w, false)
+		height = max(height, len(wrapped[i]))
+	}
+	if hasUnits(opts) {
+		// Units are one more header line, level across all columns.
+		for i := range wrapped {
+			u := cellAt(opts.Units, i)
+			if opts.Format == FormatMarkdown {
+				if u != "" {
+					wrapped[i] = append(wrapped[i], u)
+				}
+				continue
+			}
+			for len(wrapped[i]) < height {
+				wrapped[i] = append(wrapped[i], "")
+			}
+			wrapped[i] = append(wrapped[i], u)
+		}
+		height++
+	}
+
+	inner := opts
+	inner.WrapHeaders = false
+	inner.cuts = nil // rows are already sized
+	inner.Units = nil
+	inner.Headers = make([]string, len(opts.Headers))
+	if opts.Format == FormatMarkdown {
+		for i, lines := range wrapped {
+			inner.Headers[i] = strings.Join(lines, "<br>")
+		}
+		return renderLayout(ctx, inner, rows)
+	}
+	for i, lines := range wrapped {
+		inner.Headers[i] = lines[0]
+		if u := cellAt(opts.Units, i); u != "" {
+			// Size the column for its unit too.
+			hw, uw := measureWidth(lines[0], opts), measureWidth(u, opts)
+			inner.Headers[i] = alignMeasured(lines[0], hw, max(hw, uw), alignAt(opts.Alignments, i))
+		}
+	}
+	out, err := renderLayout(ctx, inner, rows)
+	if err != nil || height == 1 {
+		return out, err
+	}
+
+	// Every header line after the first is laid out like the first.
+	finalWidths, err := colWidths(ctx, inner, rows)
+	if err != nil {
+		return "", err
+	}
+	var extra []string
+	for line := 1; line < height; line++ {
+		cells := make([]string, len(wrapped))
+		for i, lines := range wrapped {
+			if line < len(lines) {
+				cells[i] = lines[line]
+			}
+		}
+		extra = append(extra, formatLine(opts.Format, cells, finalWidths, opts.Alignments, opts))
+	}
+	headerLine := 0
+	if opts.Format == FormatPlain {
+		headerLine = 1 // below the top border
+	}
+	lines := strings.Split(out, "\n")
+	if headerLine >= len(lines) {
+		return out, nil
+	}
+	lines = append(lines[:headerLine+1], append(extra, lines[headerLine+1:]...)...)
+	return strings.Join(lines, "\n"), nil
+}
+
+// longestWord returns the display width of the longest space-separated word in s.
+func longestWord(s string) int {
+	n := 0
+	for _, w := range strings.Fields(s) {
+		n = max(n, displayWidth(w))
+	}
+	return n
+}
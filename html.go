@@ -0,0 +1,209 @@
+package tablewriter
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+)
+
+// renderHTML renders rows as an HTML <table> with a <thead> for the headers
+// and one <tr> per row in <tbody>. Cell text is HTML-escaped, and columns
+// aligned center or right get an inline text-align style.
+func renderHTML(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	var b strings.Builder
+	b.WriteString("<table>\n")
+	if len(opts.Headers) > 0 {
+		b.WriteString("  <thead>\n    <tr>")
+		for i, h := range opts.Headers {
+			b.WriteString("<th" + htmlAlign(opts, i) + ">" + html.EscapeString(h) + "</th>")
+		}
+		b.WriteString("</tr>\n  </thead>\n")
+	}
+	b.WriteString("  <tbody>\n")
+	for j, r := range rows {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		b.WriteString("    <tr")
+		class := ""
+		if opts.RowClass != nil {
+			class = opts.RowClass(r)
+		}
+		if class == "" && j < len(opts.rowClasses) {
+			class = opts.rowClasses[j]
+		}
+		if class != "" {
+			b.WriteString(` class="` + html.EscapeString(class) + `"`)
+		}
+		b.WriteString(">")
+		for i, c := range r {
+			v, err := applyCellOpts(c, opts)
+			if err != nil {
+				return "", err
+			}
+			data, err := htmlData(opts, r, i)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString("<td" + htmlClass(opts, j, i) + htmlAlign(opts, i) + data + ">" + html.EscapeString(v) + "</td>")
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("  </tbody>\n</table>\n")
+	if opts.HTMLPage {
+		return htmlPage(opts.HTMLTitle, b.String()), nil
+	}
+	return b.String(), nil
+}
+
+// htmlPageStyle is the inline stylesheet of standalone pages.
+const htmlPageStyle = `body { font-family: system-ui, sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; }
+th { background: #f3f3f3; cursor: pointer; user-select: none; }
+th[aria-sort="ascending"]::after { content: " \25B2"; }
+th[aria-sort="descending"]::after { content: " \25BC"; }
+tbody tr:nth-child(even) { background: #fafafa; }`
+
+// htmlPageScript sorts the rows of each table by the clicked column,
+// comparing data-sort-value when present and numerically when both values
+// are numbers. Clicking the same header again reverses the order.
+const htmlPageScript = `document.querySelectorAll("table").forEach(function (table) {
+  table.querySelectorAll("thead th").forEach(function (th, col) {
+    th.addEventListener("click", function () {
+      var asc = th.getAttribute("aria-sort") !== "ascending";
+      table.querySelectorAll("thead th").forEach(function (h) { h.removeAttribute("aria-sort"); });
+      th.setAttribute("aria-sort", asc ? "ascending" : "descending");
+      var key = function (tr) {
+        var td = tr.children[col];
+        if (!td) return "";
+        return td.hasAttribute("data-sort-value") ? td.getAttribute("data-sort-value") : td.textContent;
+      };
+      var tbody = table.tBodies[0];
+      Array.from(tbody.rows).sort(function (a, b) {
+        var x = key(a), y = key(b), nx = parseFloat(x), ny = parseFloat(y);
+        var c = !isNaN(nx) && !isNaN(ny) && isFinite(x) && isFinite(y) ? nx - ny : x.localeCompare(y, undefined, {numeric: true});
+        return asc ? c : -c;
+      }).forEach(function (tr) { tbody.appendChild(tr); });
+    });
+  });
+});`
+
+// htmlPage wraps table in a standalone HTML document titled title.
+func htmlPage(title, table string) string {
+	if title == "" {
+		title = "Table"
+	}
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	b.WriteString("<title>" + html.EscapeString(title) + "</title>\n")
+	b.WriteString("<style>\n" + htmlPageStyle + "\n</style>\n</head>\n<body>\n")
+	b.WriteString(table)
+	b.WriteString("<script>\n" + htmlPageScript + "\n</script>\n</body>\n</html>\n")
+	return b.String()
+}
+
+// htmlClass returns the class attribute StyleRules give cell col of row j,
+// or "".
+func htmlClass(opts Options, j, col int) string {
+	if j >= len(opts.cellClasses) || col >= len(opts.cellClasses[j]) || opts.cellClasses[j][col] == "" {
+		return ""
+	}
+	return ` class="` + html.EscapeString(opts.cellClasses[j][col]) + `"`
+}
+
+// htmlAlign returns the style attribute for column col's alignment, or "".
+func htmlAlign(opts Options, col int) string {
+	switch alignAt(opts.Alignments, col) {
+	case AlignCenter:
+		return ` style="text-align: center"`
+	case AlignRight:
+		return ` style="text-align: right"`
+	default:
+		return ""
+	}
+}
+
+// htmlData returns the data-* attributes opts.CellData gives cell col of
+// row, sorted by name so output is deterministic. Empty names are skipped;
+// names are lowercased and must then match [a-z0-9-]+, or ErrInvalidOptions
+// is returned, so a name can never break out of the attribute.
+func htmlData(opts Options, row []string, col int) (string, error) {
+	if opts.CellData == nil {
+		return "", nil
+	}
+	attrs := opts.CellData(row, col)
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for _, name := range names {
+		attr := strings.ToLower(name)
+		if !validDataName(attr) {
+			return "", fmt.Errorf("%w: invalid CellData attribute name %q", ErrInvalidOptions, name)
+		}
+		b.WriteString(" data-" + attr + `="` + html.EscapeString(attrs[name]) + `"`)
+	}
+	return b.String(), nil
+}
+
+// validDataName reports whether name is non-empty and only has lowercase
+// ASCII letters, digits, and hyphens.
+func validDataName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// WithRowClass returns a copy of Options that gives each FormatHTML row the
+// CSS class f returns; "" leaves the row unclassed.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithRowClass(func(row []string) string {
+//	    if row[2] == "failed" {
+//	        return "error"
+//	    }
+//	    return ""
+//	})
+func (o Options) WithRowClass(f func(row []string) string) Options {
+	o.RowClass = f
+	return o
+}
+
+// WithCellData returns a copy of Options that adds the data-* attributes f
+// returns to each FormatHTML data cell.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithCellData(func(row []string, col int) map[string]string {
+//	    return map[string]string{"sort-value": row[col]}
+//	})
+func (o Options) WithCellData(f func(row []string, col int) map[string]string) Options {
+	o.CellData = f
+	return o
+}
+
+// WithHTMLPage returns a copy of Options that wraps FormatHTML output in a
+// standalone, sortable page titled title; "" keeps the default title.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithHTMLPage("Q3 report")
+func (o Options) WithHTMLPage(title string) Options {
+	o.HTMLPage = true
+	o.HTMLTitle = title
+	return o
+}
@@ -0,0 +1,118 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestFormatHTML(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers:    []string{"Check", "Status"},
+		Format:     tablewriter.FormatHTML,
+		Alignments: []tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignRight},
+		RowClass: func(row []string) string {
+			if row[1] == "FAIL" {
+				return "error"
+			}
+			return ""
+		},
+	}
+	out, err := tablewriter.Render(opts, [][]string{{"disk <90%", "OK"}, {"db", "FAIL"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	want := `<table>
+  <thead>
+    <tr><th>Check</th><th style="text-align: right">Status</th></tr>
+  </thead>
+  <tbody>
+    <tr><td>disk &lt;90%</td><td style="text-align: right">OK</td></tr>
+    <tr class="error"><td>db</td><td style="text-align: right">FAIL</td></tr>
+  </tbody>
+</table>
+`
+	if out != want {
+		t.Errorf("Render() = %s, want %s", out, want)
+	}
+}
+
+func TestWithFormatHTML(t *testing.T) {
+	opts, err := tablewriter.DefaultOptions().WithFormat(tablewriter.FormatHTML)
+	if err != nil || opts.Format != tablewriter.FormatHTML {
+		t.Errorf("WithFormat(FormatHTML) = %v, %v", opts.Format, err)
+	}
+}
+
+func TestFormatHTMLEmpty(t *testing.T) {
+	opts := tablewriter.Options{Headers: []string{"Name"}, Format: tablewriter.FormatHTML, EmptyMessage: "(none)"}
+	out, err := tablewriter.Render(opts, nil)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.HasSuffix(out, "  <tbody>\n  </tbody>\n</table>\n") {
+		t.Errorf("Render() = %s, want empty tbody ending the table", out)
+	}
+}
+
+func TestFormatHTMLCellData(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers: []string{"ID", "Size"},
+		Format:  tablewriter.FormatHTML,
+		CellData: func(row []string, col int) map[string]string {
+			if col == 1 {
+				return map[string]string{"sort-value": "1536", "id": row[0]}
+			}
+			return nil
+		},
+	}
+	out, err := tablewriter.Render(opts, [][]string{{"a\"1", "1.5 KiB"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	want := `<tr><td>a&#34;1</td><td data-id="a&#34;1" data-sort-value="1536">1.5 KiB</td></tr>`
+	if !strings.Contains(out, want) {
+		t.Errorf("Render() = %s, want row %s", out, want)
+	}
+}
+
+func TestFormatHTMLCellDataInvalidName(t *testing.T) {
+	for _, name := range []string{`x" onmouseover="alert(1)`, "a b", "sort_value", "ключ"} {
+		opts := tablewriter.Options{
+			Format: tablewriter.FormatHTML,
+			CellData: func(row []string, col int) map[string]string {
+				return map[string]string{name: "1"}
+			},
+		}
+		out, err := tablewriter.Render(opts, [][]string{{"a"}})
+		if !errors.Is(err, tablewriter.ErrInvalidOptions) {
+			t.Errorf("Render() with name %q = %q, %v, want %v", name, out, err, tablewriter.ErrInvalidOptions)
+		}
+	}
+}
+
+func TestFormatHTMLPage(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers:   []string{"Name"},
+		Format:    tablewriter.FormatHTML,
+		HTMLPage:  true,
+		HTMLTitle: "Q3 <report>",
+	}
+	out, err := tablewriter.Render(opts, [][]string{{"alice"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	for _, want := range []string{
+		"<!DOCTYPE html>",
+		"<title>Q3 &lt;report&gt;</title>",
+		"<td>alice</td>",
+		"<script>",
+		"</html>\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Render() missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package tablewriter
+
+import "strings"
+
+// TypeInference is the type InferTypes detected for one column.
+type TypeInference struct {
+	// Column is the column's header, or "" for a table without headers.
+	Column string
+
+	// Type is the detected type; TypeTime stands for dates and times.
+	Type ColumnType
+
+	// Confidence is the fraction of the column's non-empty cells that
+	// support Type: those that parse as it, or for TypeString, those that
+	// parse as no other type. A column without non-empty cells is
+	// TypeString with confidence 0.
+	Confidence float64
+}
+
+// TypeReport lists the inferred type of each column, in column order.
+type TypeReport []TypeInference
+
+// InferTypes analyzes the cells of each column and reports the type they
+// hold: the type, among Int, Float, Bool, and Time, that most non-empty
+// cells parse as, preferring the narrowest on ties, or TypeString if that
+// is at most half of them. Stray values such as "n/a" in a numeric column
+// lower the confidence rather than the type.
+//
+// Example:
+//
+//	report := t.InferTypes()
+//	for _, c := range report {
+//	    fmt.Printf("%s: %v (%.0f%%)\n", c.Column, c.Type, 100*c.Confidence)
+//	}
+func (t *Table) InferTypes() TypeReport {
+	headers := resolveColumns(t.opts).Headers
+	report := make(TypeReport, max(len(headers), t.columnCount()))
+	for i := range report {
+		report[i] = inferType(t.rows, i)
+		report[i].Column = cellAt(headers, i)
+	}
+	return report
+}
+
+// ColumnTypes returns the report's types for Options.ColumnTypes, with
+// TypeString for columns detected with less than minConfidence, so the
+// report can seed alignment, JSON typing, and SQL column types.
+//
+// Example:
+//
+//	opts := t.Options().WithColumnTypes(t.InferTypes().ColumnTypes(0.95)...)
+func (r TypeReport) ColumnTypes(minConfidence float64) []ColumnType {
+	types := make([]ColumnType, len(r))
+	for i, c := range r {
+		if c.Confidence >= minConfidence {
+			types[i] = c.Type
+		}
+	}
+	return types
+}
+
+// inferType returns the type and confidence of column col of rows.
+func inferType(rows [][]string, col int) TypeInference {
+	candidates := []ColumnType{TypeInt, TypeFloat, TypeBool, TypeTime}
+	matched := make([]int, len(candidates))
+	total, untyped := 0, 0
+	for _, r := range rows {
+		v := cellAt(r, col)
+		if v == Null || strings.TrimSpace(v) == "" {
+			continue
+		}
+		total++
+		typed := false
+		for i, t := range candidates {
+			if _, ok := t.parse(v); ok {
+				matched[i]++
+				typed = true
+			}
+		}
+		if !typed {
+			untyped++
+		}
+	}
+	if total == 0 {
+		return TypeInference{Type: TypeString}
+	}
+	best := 0
+	for i := range candidates {
+		if matched[i] > matched[best] {
+			best = i
+		}
+	}
+	if 2*matched[best] <= total {
+		return TypeInference{Type: TypeString, Confidence: float64(untyped) / float64(total)}
+	}
+	return TypeInference{Type: candidates[best], Confidence: float64(matched[best]) / float64(total)}
+}
@@ -0,0 +1,40 @@
+package tablewriter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestInferTypes(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"ID", "Price", "Active", "Created", "Name", "Empty"}})
+	rows := [][]string{
+		{"1", "9.99", "true", "2024-01-02", "alice", ""},
+		{"2", "12", "false", "2024-02-03 10:00:00", "bob", ""},
+		{"3", "n/a", "true", "2024-03-04T05:06:07Z", "7", ""},
+		{"4", "", "false", "", "carol", ""},
+	}
+	if err := tbl.AddRows(rows); err != nil {
+		t.Fatal(err)
+	}
+	want := tablewriter.TypeReport{
+		{Column: "ID", Type: tablewriter.TypeInt, Confidence: 1},
+		{Column: "Price", Type: tablewriter.TypeFloat, Confidence: 2.0 / 3},
+		{Column: "Active", Type: tablewriter.TypeBool, Confidence: 1},
+		{Column: "Created", Type: tablewriter.TypeTime, Confidence: 1},
+		{Column: "Name", Type: tablewriter.TypeString, Confidence: 0.75},
+		{Column: "Empty", Type: tablewriter.TypeString},
+	}
+	report := tbl.InferTypes()
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("InferTypes() = %+v, want %+v", report, want)
+	}
+	wantTypes := []tablewriter.ColumnType{
+		tablewriter.TypeInt, tablewriter.TypeString, tablewriter.TypeBool,
+		tablewriter.TypeTime, tablewriter.TypeString, tablewriter.TypeString,
+	}
+	if got := report.ColumnTypes(0.9); !reflect.DeepEqual(got, wantTypes) {
+		t.Errorf("ColumnTypes(0.9) = %v, want %v", got, wantTypes)
+	}
+}
@@ -0,0 +1,126 @@
+package tablewriter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// jsonSchemaDraft is the JSON Schema dialect RenderJSONSchema declares.
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// RenderJSONSchema returns a JSON Schema document describing the FormatJSON
+// output for opts and rows: an array of objects with one required property
+// per column. Int, Float, and Bool columns are integers, numbers, and
+// booleans, nullable if any cell is empty; other columns are strings, with
+// format "date-time" or "date" when every cell is one. Columns without a
+// declared type are strings in FormatJSON output and are described as such;
+// declare types, e.g. from Table.InferTypes, to export and describe them as
+// numbers or booleans. NestedJSON headers become nested objects.
+//
+// Example:
+//
+//	opts = opts.WithColumnTypes(t.InferTypes().ColumnTypes(1)...)
+//	data, err := tablewriter.Render(opts, rows)
+//	schema, err := tablewriter.RenderJSONSchema(opts, rows)
+func RenderJSONSchema(opts Options, rows [][]string) (string, error) {
+	return renderJSONSchema(opts, rows, nil)
+}
+
+// RenderJSONSchema returns a JSON Schema document describing the table's
+// FormatJSON output; see the package-level RenderJSONSchema.
+//
+// Example:
+//
+//	schema, err := t.RenderJSONSchema()
+func (t *Table) RenderJSONSchema() (string, error) {
+	return renderJSONSchema(t.opts, t.rows, t.meta)
+}
+
+// renderJSONSchema prepares rows as for FormatJSON and describes them.
+func renderJSONSchema(opts Options, rows [][]string, meta []RowMeta) (string, error) {
+	opts.Format = FormatJSON
+	opts, rows, err := prepare(opts, rows, meta)
+	if err != nil {
+		return "", err
+	}
+	if len(opts.Headers) == 0 {
+		return "", ErrMissingHeaders
+	}
+	item := &Schema{Type: SchemaType{"object"}}
+	for i, h := range opts.Headers {
+		path := []string{h}
+		if opts.NestedJSON {
+			path = strings.Split(h, ".")
+		}
+		parent := item
+		for _, k := range path[:len(path)-1] {
+			child, ok := parent.Properties[k]
+			switch {
+			case !ok:
+				child = &Schema{Type: SchemaType{"object"}}
+				addProperty(parent, k, child)
+			case !child.allows("object"):
+				return "", fmt.Errorf("%w: %q", ErrNestedKeyConflict, h)
+			}
+			parent = child
+		}
+		last := path[len(path)-1]
+		if _, exists := parent.Properties[last]; exists {
+			return "", fmt.Errorf("%w: %q", ErrNestedKeyConflict, h)
+		}
+		addProperty(parent, last, columnSchema(typeAt(opts, i), rows, i))
+	}
+	doc := &Schema{SchemaURI: jsonSchemaDraft, Type: SchemaType{"array"}, Items: item}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
+
+// addProperty adds a required property to the object schema s.
+func addProperty(s *Schema, name string, prop *Schema) {
+	if s.Properties == nil {
+		s.Properties = map[string]*Schema{}
+	}
+	s.Properties[name] = prop
+	s.Required = append(s.Required, name)
+}
+
+// columnSchema describes the JSON values FormatJSON encodes for column col
+// of type t.
+func columnSchema(t ColumnType, rows [][]string, col int) *Schema {
+	name := map[ColumnType]string{TypeInt: "integer", TypeFloat: "number", TypeBool: "boolean"}[t]
+	if name == "" {
+		return &Schema{Type: SchemaType{"string"}, Format: stringFormat(rows, col)}
+	}
+	for _, r := range rows {
+		if strings.TrimSpace(cellAt(r, col)) == "" {
+			return &Schema{Type: SchemaType{name, "null"}}
+		}
+	}
+	return &Schema{Type: SchemaType{name}}
+}
+
+// stringFormat returns "date-time" or "date" if every cell of column col
+// is one, or "" otherwise.
+func stringFormat(rows [][]string, col int) string {
+	if len(rows) == 0 {
+		return ""
+	}
+	for _, f := range []struct{ name, layout string }{{"date-time", time.RFC3339}, {"date", "2006-01-02"}} {
+		ok := true
+		for _, r := range rows {
+			if _, err := time.Parse(f.layout, cellAt(r, col)); err != nil {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return f.name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRenderJSONSchema(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers:     []string{"id", "score", "user.name", "user.joined"},
+		ColumnTypes: []tablewriter.ColumnType{tablewriter.TypeInt, tablewriter.TypeFloat},
+		NestedJSON:  true,
+	}
+	rows := [][]string{{"1", "9.5", "alice", "2024-01-02"}, {"2", "", "bob", "2024-02-03"}}
+	got, err := tablewriter.RenderJSONSchema(opts, rows)
+	if err != nil {
+		t.Fatalf("RenderJSONSchema() error = %v", err)
+	}
+	want := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "array",
+  "items": {
+    "type": "object",
+    "properties": {
+      "id": {
+        "type": "integer"
+      },
+      "score": {
+        "type": [
+          "number",
+          "null"
+        ]
+      },
+      "user": {
+        "type": "object",
+        "properties": {
+          "joined": {
+            "type": "string",
+            "format": "date"
+          },
+          "name": {
+            "type": "string"
+          }
+        },
+        "required": [
+          "name",
+          "joined"
+        ]
+      }
+    },
+    "required": [
+      "id",
+      "score",
+      "user"
+    ]
+  }
+}
+`
+	if got != want {
+		t.Errorf("RenderJSONSchema() =\n%s\nwant\n%s", got, want)
+	}
+
+	schema, err := tablewriter.ParseJSONSchema([]byte(got))
+	if err != nil {
+		t.Fatalf("ParseJSONSchema() error = %v", err)
+	}
+	opts.Schema = schema
+	opts.Format = tablewriter.FormatMarkdown
+	if _, err := tablewriter.Render(opts, rows); err != nil {
+		t.Errorf("Render() with own schema error = %v", err)
+	}
+	rows = append(rows, []string{"3", "", "carol", "soon"})
+	if _, err := tablewriter.Render(opts, rows); !errors.Is(err, tablewriter.ErrSchemaViolation) {
+		t.Errorf("Render() with invalid date error = %v, want ErrSchemaViolation", err)
+	}
+}
+
+func TestRenderJSONSchemaErrors(t *testing.T) {
+	if _, err := tablewriter.RenderJSONSchema(tablewriter.Options{}, nil); !errors.Is(err, tablewriter.ErrMissingHeaders) {
+		t.Errorf("no headers: error = %v, want ErrMissingHeaders", err)
+	}
+	opts := tablewriter.Options{Headers: []string{"user", "user.name"}, NestedJSON: true}
+	if _, err := tablewriter.RenderJSONSchema(opts, nil); !errors.Is(err, tablewriter.ErrNestedKeyConflict) {
+		t.Errorf("conflict: error = %v, want ErrNestedKeyConflict", err)
+	}
+}
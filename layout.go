@@ -0,0 +1,56 @@
+package tablewriter
+
+import "strings"
+
+// isTextFormat reports whether f is laid out in aligned columns, as opposed
+// to a data interchange format such as CSV or JSON.
+func isTextFormat(f Format) bool {
+	switch f {
+	case FormatPlain, FormatSimple, FormatMarkdown:
+		return true
+	default:
+		return false
+	}
+}
+
+// tableWidth returns the rendered line width of a table in format f whose
+// columns have the given content widths, including borders and padding.
+func tableWidth(f Format, widths []int) int {
+	n := len(widths)
+	if n == 0 {
+		return 0
+	}
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+	switch f {
+	case FormatSimple:
+		// "a  b  c"
+		return total + 2*(n-1)
+	default:
+		// "│ a │ b │ c │" and "| a | b | c |"
+		return total + 3*n + 1
+	}
+}
+
+// formatLine lays out one line of cells in text format f, padded to widths,
+// using the same separators as the format's renderer.
+func formatLine(f Format, cells []string, widths []int, aligns []Alignment, opts Options) string {
+	parts := make([]string, len(widths))
+	for i, w := range widths {
+		c := ""
+		if i < len(cells) {
+			c = cells[i]
+		}
+		parts[i] = alignMeasured(c, measureWidth(c, opts), w, alignAt(aligns, i))
+	}
+	switch f {
+	case FormatSimple:
+		return strings.Join(parts, "  ")
+	case FormatMarkdown:
+		return "| " + strings.Join(parts, " | ") + " |"
+	default:
+		return "│ " + strings.Join(parts, " │ ") + " │"
+	}
+}
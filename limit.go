@@ -0,0 +1,44 @@
+package tablewriter
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// limitOutput cuts out to at most limit bytes, ending it with a truncation
+// notice. It keeps whole lines when at least one fits, and otherwise cuts
+// the first line at a UTF-8 boundary.
+func limitOutput(out string, limit int) string {
+	if len(out) <= limit {
+		return out
+	}
+	notice := fmt.Sprintf("[output truncated at %d bytes]\n", limit)
+	budget := limit - len(notice)
+	if budget <= 0 {
+		return notice[:limit]
+	}
+	cut := out[:budget]
+	if i := strings.LastIndexByte(cut, '\n'); i >= 0 {
+		return cut[:i+1] + notice
+	}
+	for len(cut) > 0 && !utf8.RuneStart(out[len(cut)]) {
+		cut = cut[:len(cut)-1]
+	}
+	return cut + "\n" + notice[:len(notice)-1]
+}
+
+// WithMaxOutputBytes returns a copy of Options that caps rendered output at
+// n bytes, ending cut output with a notice; 0 removes the cap. Returns
+// ErrInvalidOptions if n is negative.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithMaxOutputBytes(4000)
+func (o Options) WithMaxOutputBytes(n int) (Options, error) {
+	if n < 0 {
+		return o, fmt.Errorf("invalid max output bytes %d: %w", n, ErrInvalidOptions)
+	}
+	o.MaxOutputBytes = n
+	return o, nil
+}
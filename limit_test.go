@@ -0,0 +1,59 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestMaxOutputBytes(t *testing.T) {
+	rows := [][]string{{"alpha", "1"}, {"beta", "2"}, {"gamma", "3"}, {"delta", "4"}, {"epsilon", "5"}, {"zeta", "6"}}
+	tests := []struct {
+		name  string
+		limit int
+		want  string
+	}{
+		{"fits", 100, "Name,N\nalpha,1\nbeta,2\ngamma,3\ndelta,4\nepsilon,5\nzeta,6\n"},
+		{"whole lines", 48, "Name,N\nalpha,1\n[output truncated at 48 bytes]\n"},
+		{"partial line", 37, "Name,N\n[output truncated at 37 bytes]"},
+		{"notice only", 10, "[output tr"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Format: tablewriter.FormatCSV, CSVDelimiter: ',', Headers: []string{"Name", "N"}, MaxOutputBytes: tt.limit}
+			got, err := tablewriter.Render(opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+			if len(got) > tt.limit {
+				t.Errorf("len(Render()) = %d, want at most %d", len(got), tt.limit)
+			}
+		})
+	}
+}
+
+func TestMaxOutputBytesSingleLine(t *testing.T) {
+	opts := tablewriter.Options{Format: tablewriter.FormatCSV, CSVDelimiter: ',', MaxOutputBytes: 36}
+	got, err := tablewriter.Render(opts, [][]string{{strings.Repeat("é", 30)}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "éé\n[output truncated at 36 bytes]"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestWithMaxOutputBytes(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithMaxOutputBytes(-1); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithMaxOutputBytes(-1) error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+	opts, err := tablewriter.DefaultOptions().WithMaxOutputBytes(4000)
+	if err != nil || opts.MaxOutputBytes != 4000 {
+		t.Errorf("WithMaxOutputBytes(4000) = %d, %v", opts.MaxOutputBytes, err)
+	}
+}
@@ -0,0 +1,120 @@
+package tablewriter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LintLongCellWidth is the display width above which Lint reports a cell as
+// suspiciously long.
+const LintLongCellWidth = 200
+
+// LintKind classifies a structural issue found by Lint.
+type LintKind int
+
+const (
+	LintRaggedRow       LintKind = iota // LintRaggedRow is a row whose cell count differs from the header count.
+	LintEmptyColumn                     // LintEmptyColumn is a column whose cells are all empty.
+	LintDuplicateHeader                 // LintDuplicateHeader is a header name used by more than one column.
+	LintLongCell                        // LintLongCell is a cell wider than LintLongCellWidth.
+)
+
+// LintIssue is one structural problem in a table.
+type LintIssue struct {
+	// Kind classifies the issue.
+	Kind LintKind
+
+	// Row is the data row index, or -1 if the issue is not about one row.
+	Row int
+
+	// Column is the column index, or -1 if the issue is not about one column.
+	Column int
+
+	// Message describes the issue for people.
+	Message string
+}
+
+// LintReport lists the issues found by Lint, ordered by kind and position.
+type LintReport struct {
+	Issues []LintIssue
+}
+
+// OK reports whether no issues were found.
+func (r LintReport) OK() bool {
+	return len(r.Issues) == 0
+}
+
+// String returns one issue message per line.
+func (r LintReport) String() string {
+	lines := make([]string, len(r.Issues))
+	for i, is := range r.Issues {
+		lines[i] = is.Message
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Lint checks the table's stored headers and rows for structural issues —
+// ragged rows, completely empty columns, duplicate headers, and cells wider
+// than LintLongCellWidth — so pipelines can validate a table before
+// publishing it. Lint does not modify the table.
+//
+// Example:
+//
+//	if report := t.Lint(); !report.OK() {
+//	    return fmt.Errorf("invalid table:\n%s", report)
+//	}
+func (t *Table) Lint() LintReport {
+	headers := resolveColumns(t.opts).Headers
+	var issues []LintIssue
+
+	if n := len(headers); n > 0 {
+		for i, r := range t.rows {
+			if len(r) != n {
+				issues = append(issues, LintIssue{LintRaggedRow, i, -1,
+					fmt.Sprintf("row %d has %d cells, want %d", i, len(r), n)})
+			}
+		}
+	}
+
+	numCols := len(headers)
+	for _, r := range t.rows {
+		if len(r) > numCols {
+			numCols = len(r)
+		}
+	}
+	if len(t.rows) > 0 {
+		for c := 0; c < numCols; c++ {
+			empty := true
+			for _, r := range t.rows {
+				if strings.TrimSpace(cellAt(r, c)) != "" {
+					empty = false
+					break
+				}
+			}
+			if empty {
+				issues = append(issues, LintIssue{LintEmptyColumn, -1, c,
+					fmt.Sprintf("column %d (%q) is empty", c, cellAt(headers, c))})
+			}
+		}
+	}
+
+	first := map[string]int{}
+	for c, h := range headers {
+		if prev, ok := first[h]; ok {
+			issues = append(issues, LintIssue{LintDuplicateHeader, -1, c,
+				fmt.Sprintf("column %d repeats header %q of column %d", c, h, prev)})
+			continue
+		}
+		first[h] = c
+	}
+
+	for i, r := range t.rows {
+		for c, v := range r {
+			if w := displayWidth(v); w > LintLongCellWidth {
+				issues = append(issues, LintIssue{LintLongCell, i, c,
+					fmt.Sprintf("row %d column %d is %d characters wide", i, c, w)})
+			}
+		}
+	}
+	return LintReport{Issues: issues}
+}
@@ -0,0 +1,45 @@
+package tablewriter_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestLint(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Name", "Note", "Name"}})
+	tbl.AddRow("a", "", "x")
+	tbl.AddRow("b", " ")
+	tbl.AddRow("c", "", strings.Repeat("x", tablewriter.LintLongCellWidth+1))
+
+	report := tbl.Lint()
+	var kinds []tablewriter.LintKind
+	for _, is := range report.Issues {
+		kinds = append(kinds, is.Kind)
+	}
+	want := []tablewriter.LintKind{
+		tablewriter.LintRaggedRow,
+		tablewriter.LintEmptyColumn,
+		tablewriter.LintDuplicateHeader,
+		tablewriter.LintLongCell,
+	}
+	if !reflect.DeepEqual(kinds, want) {
+		t.Fatalf("Lint() kinds = %v, want %v\n%s", kinds, want, report)
+	}
+	if report.OK() {
+		t.Error("Lint().OK() = true, want false")
+	}
+	if !strings.Contains(report.String(), `row 1 has 2 cells, want 3`) {
+		t.Errorf("Lint().String() = %q", report.String())
+	}
+}
+
+func TestLintClean(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Name", "Age"}})
+	tbl.AddRow("Alice", "30")
+	if report := tbl.Lint(); !report.OK() {
+		t.Errorf("Lint() = %s, want no issues", report)
+	}
+}
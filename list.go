@@ -0,0 +1,31 @@
+package tablewriter
+
+import (
+	"context"
+	"strings"
+)
+
+// renderList renders each row on its own line as "Header: value" pairs
+// separated by "; ". Cells without a header are listed by value alone.
+func renderList(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	var b strings.Builder
+	for _, r := range rows {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		n := max(len(r), len(opts.Headers))
+		parts := make([]string, 0, n)
+		for i := 0; i < n; i++ {
+			v, err := applyCellOpts(cellAt(r, i), opts)
+			if err != nil {
+				return "", err
+			}
+			if h := cellAt(opts.Headers, i); h != "" {
+				v = h + ": " + v
+			}
+			parts = append(parts, v)
+		}
+		b.WriteString(strings.Join(parts, "; ") + "\n")
+	}
+	return b.String(), nil
+}
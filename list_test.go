@@ -0,0 +1,60 @@
+package tablewriter_test
+
+import (
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestFormatList(t *testing.T) {
+	tests := []struct {
+		name string
+		opts tablewriter.Options
+		rows [][]string
+		want string
+	}{
+		{
+			"headers",
+			tablewriter.Options{Headers: []string{"Name", "Age", "City"}, NullPlaceholder: "-"},
+			[][]string{{"Alice", "30", "NYC"}, {"Bob", "", "LA"}},
+			"Name: Alice; Age: 30; City: NYC\nName: Bob; Age: -; City: LA\n",
+		},
+		{
+			"labels",
+			tablewriter.Options{Headers: []string{"created_at"}, HeaderCase: tablewriter.SentenceCase},
+			[][]string{{"today"}},
+			"Created at: today\n",
+		},
+		{
+			"no headers",
+			tablewriter.Options{},
+			[][]string{{"a", "b"}},
+			"a; b\n",
+		},
+		{
+			"empty",
+			tablewriter.Options{Headers: []string{"Name"}, EmptyMessage: "(no rows)"},
+			[][]string{},
+			"(no rows)\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Format = tablewriter.FormatList
+			out, err := tablewriter.Render(tt.opts, tt.rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithFormatList(t *testing.T) {
+	opts, err := tablewriter.DefaultOptions().WithFormat(tablewriter.FormatList)
+	if err != nil || opts.Format != tablewriter.FormatList {
+		t.Errorf("WithFormat(FormatList) = %v, %v", opts.Format, err)
+	}
+}
@@ -0,0 +1,108 @@
+package tablewriter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// LiveTable is a Table that redraws itself in place on a terminal using ANSI
+// cursor movement, for progress dashboards that should not flood the
+// scrollback. It is safe for concurrent use.
+type LiveTable struct {
+	mu    sync.Mutex
+	w     io.Writer
+	table *Table
+	lines int
+}
+
+// NewLiveTable creates a LiveTable that draws to w with the provided Options.
+//
+// Example:
+//
+//	lt := tablewriter.NewLiveTable(os.Stdout, tablewriter.Options{
+//	    Headers: []string{"File", "Progress"},
+//	})
+//	lt.AddRow("a.zip", "0%")
+//	lt.UpdateRow(0, "a.zip", "50%")
+func NewLiveTable(w io.Writer, opts Options) *LiveTable {
+	return &LiveTable{w: w, table: New(opts)}
+}
+
+// AddRow appends a row and redraws the table.
+//
+// Example:
+//
+//	err := lt.AddRow("b.zip", "0%")
+func (l *LiveTable) AddRow(cols ...string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.table.AddRow(cols...); err != nil {
+		return err
+	}
+	return l.redraw()
+}
+
+// UpdateRow replaces the row at index i and redraws the table.
+//
+// Example:
+//
+//	err := lt.UpdateRow(0, "a.zip", "100%")
+func (l *LiveTable) UpdateRow(i int, cols ...string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.table.SetRow(i, cols...); err != nil {
+		return err
+	}
+	return l.redraw()
+}
+
+// SetRows replaces all rows and redraws the table.
+//
+// Example:
+//
+//	err := lt.SetRows(latest)
+func (l *LiveTable) SetRows(rows [][]string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.table.Reset()
+	if err := l.table.AddRows(rows); err != nil {
+		return err
+	}
+	return l.redraw()
+}
+
+// Refresh redraws the table without changing it.
+//
+// Example:
+//
+//	err := lt.Refresh()
+func (l *LiveTable) Refresh() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.redraw()
+}
+
+// redraw moves the cursor back over the previous drawing, clears it, and
+// writes the current rendering. The caller must hold l.mu.
+func (l *LiveTable) redraw() error {
+	out, err := l.table.RenderErr()
+	if err != nil {
+		return err
+	}
+	if !strings.HasSuffix(out, "\n") {
+		out += "\n"
+	}
+	var b strings.Builder
+	if l.lines > 0 {
+		fmt.Fprintf(&b, "\x1b[%dA", l.lines)
+	}
+	b.WriteString("\r\x1b[J")
+	b.WriteString(out)
+	if _, err := io.WriteString(l.w, b.String()); err != nil {
+		return err
+	}
+	l.lines = strings.Count(out, "\n")
+	return nil
+}
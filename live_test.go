@@ -0,0 +1,36 @@
+package tablewriter_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestLiveTable(t *testing.T) {
+	var buf bytes.Buffer
+	lt := tablewriter.NewLiveTable(&buf, tablewriter.Options{
+		Headers: []string{"File", "Progress"},
+		Format:  tablewriter.FormatCSV,
+	})
+	if err := lt.AddRow("a.zip", "0%"); err != nil {
+		t.Fatalf("AddRow() error = %v", err)
+	}
+	if want := "\r\x1b[JFile,Progress\na.zip,0%\n"; buf.String() != want {
+		t.Errorf("AddRow() wrote %q, want %q", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := lt.UpdateRow(0, "a.zip", "50%"); err != nil {
+		t.Fatalf("UpdateRow() error = %v", err)
+	}
+	want := "\x1b[2A\r\x1b[JFile,Progress\na.zip,50%\n"
+	if buf.String() != want {
+		t.Errorf("UpdateRow() wrote %q, want %q", buf.String(), want)
+	}
+
+	if err := lt.UpdateRow(5, "x", "y"); !errors.Is(err, tablewriter.ErrRowOutOfRange) {
+		t.Errorf("UpdateRow() out of range error = %v, want %v", err, tablewriter.ErrRowOutOfRange)
+	}
+}
@@ -0,0 +1,28 @@
+package tablewriter
+
+import "sort"
+
+// RenderMap renders m as a two-column table with one row per key, sorted by
+// key. The headers default to "Key" and "Value" unless opts.Headers is set.
+//
+// Example:
+//
+//	out, err := tablewriter.RenderMap(map[string]string{
+//	    "version": "1.2.0",
+//	    "commit":  "a1b2c3d",
+//	}, tablewriter.Options{Format: tablewriter.FormatSimple})
+func RenderMap(m map[string]string, opts Options) (string, error) {
+	if len(opts.Headers) == 0 {
+		opts.Headers = []string{"Key", "Value"}
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	rows := make([][]string, len(keys))
+	for i, k := range keys {
+		rows[i] = []string{k, m[k]}
+	}
+	return Render(opts, rows)
+}
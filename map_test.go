@@ -0,0 +1,30 @@
+package tablewriter_test
+
+import (
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRenderMap(t *testing.T) {
+	m := map[string]string{"version": "1.2.0", "commit": "a1b2c3d", "branch": "main"}
+	tests := []struct {
+		name    string
+		headers []string
+		want    string
+	}{
+		{"default headers", nil, "Key,Value\nbranch,main\ncommit,a1b2c3d\nversion,1.2.0\n"},
+		{"custom headers", []string{"Field", "Setting"}, "Field,Setting\nbranch,main\ncommit,a1b2c3d\nversion,1.2.0\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := tablewriter.RenderMap(m, tablewriter.Options{Headers: tt.headers, Format: tablewriter.FormatCSV})
+			if err != nil {
+				t.Fatalf("RenderMap() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("RenderMap() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
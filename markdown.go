@@ -0,0 +1,183 @@
+package tablewriter
+
+import (
+	"context"
+	"strings"
+)
+
+// minMarkdownDashes is the fewest dashes a separator cell may contain; some
+// strict CommonMark/GFM parsers reject shorter delimiter rows.
+const minMarkdownDashes = 3
+
+// customMarkdown reports whether opts asks for Markdown output that differs
+// from the standard renderer.
+func customMarkdown(opts Options) bool {
+	return opts.MarkdownLoose || opts.MarkdownCompactSeparator
+}
+
+// renderMarkdownCustom renders rows as a Markdown pipe table according to
+// the Markdown options in opts. The loose style has no leading or trailing
+// pipes and no padding, e.g.
+//
+//	Name | Age
+//	--- | ---:
+//	alice | 30
+//
+// Pipes inside cells were escaped by prepareFormat, and trailing empty
+// cells leave no trailing space. Single-column loose tables keep a leading
+// pipe, without which the separator line would read as a heading underline.
+func renderMarkdownCustom(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	n := len(opts.Headers)
+	for _, r := range rows {
+		n = max(n, len(r))
+	}
+	cells := make([][]string, len(rows))
+	for j, r := range rows {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		cells[j] = make([]string, n)
+		for i := range cells[j] {
+			v, err := applyCellOpts(cellAt(r, i), opts)
+			if err != nil {
+				return "", err
+			}
+			cells[j][i] = v
+		}
+	}
+	headers := make([]string, n)
+	for i := range headers {
+		headers[i] = cellAt(opts.Headers, i)
+	}
+	aligns := make([]Alignment, n)
+	for i := range aligns {
+		aligns[i] = alignAt(opts.Alignments, i)
+	}
+
+	var b strings.Builder
+	if opts.MarkdownLoose {
+		lead := ""
+		if n == 1 {
+			lead = "| "
+		}
+		line := func(cells []string) {
+			b.WriteString(strings.TrimRight(lead+strings.Join(cells, " | "), " ") + "\n")
+		}
+		if len(opts.Headers) > 0 {
+			line(headers)
+			seps := make([]string, n)
+			for i := range seps {
+				seps[i] = markdownSeparator(aligns[i], 0)
+			}
+			if opts.MarkdownCompactSeparator {
+				b.WriteString(strings.TrimSuffix(lead, " ") + strings.Join(seps, "|") + "\n")
+			} else {
+				line(seps)
+			}
+		}
+		for _, r := range cells {
+			line(r)
+		}
+		return b.String(), nil
+	}
+
+	widths := make([]int, n)
+	for i := range widths {
+		widths[i] = minMarkdownDashes + strings.Count(markdownSeparator(aligns[i], 0), ":")
+		if len(opts.Headers) > 0 {
+			widths[i] = max(widths[i], measureWidth(headers[i], opts))
+		}
+		for _, r := range cells {
+			widths[i] = max(widths[i], measureWidth(r[i], opts))
+		}
+	}
+	line := func(cells []string) {
+		padded := make([]string, n)
+		for i, c := range cells {
+			padded[i] = alignMeasured(c, measureWidth(c, opts), widths[i], aligns[i])
+		}
+		b.WriteString("| " + strings.Join(padded, " | ") + " |\n")
+	}
+	if len(opts.Headers) > 0 {
+		line(headers)
+		seps := make([]string, n)
+		for i := range seps {
+			if opts.MarkdownCompactSeparator {
+				seps[i] = markdownSeparator(aligns[i], widths[i]+2)
+			} else {
+				seps[i] = markdownSeparator(aligns[i], widths[i])
+			}
+		}
+		if opts.MarkdownCompactSeparator {
+			b.WriteString("|" + strings.Join(seps, "|") + "|\n")
+		} else {
+			b.WriteString("| " + strings.Join(seps, " | ") + " |\n")
+		}
+	}
+	for _, r := range cells {
+		line(r)
+	}
+	return b.String(), nil
+}
+
+// markdownSeparator returns the separator cell for a column with alignment
+// a, width characters wide including colons but never with fewer than
+// minMarkdownDashes dashes.
+func markdownSeparator(a Alignment, width int) string {
+	left, right := "", ""
+	switch a {
+	case AlignCenter:
+		left, right = ":", ":"
+	case AlignRight:
+		right = ":"
+	}
+	dashes := max(width-len(left)-len(right), minMarkdownDashes)
+	return left + strings.Repeat("-", dashes) + right
+}
+
+// markdownEscape escapes pipes in s so they do not end the cell.
+func markdownEscape(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+// escapeMarkdown escapes pipes in rows and in the headers, footers, units,
+// and null placeholder of opts, before columns are measured, so the
+// sequential and parallel renderers and RenderAppend lay out the same text.
+func escapeMarkdown(opts Options, rows [][]string) Options {
+	opts.Headers = mapCells(opts.Headers, markdownEscape)
+	opts.Units = mapCells(opts.Units, markdownEscape)
+	opts.NullPlaceholder = markdownEscape(opts.NullPlaceholder)
+	if opts.Footers != nil {
+		footers := make([][]string, len(opts.Footers))
+		for i, f := range opts.Footers {
+			footers[i] = mapCells(f, markdownEscape)
+		}
+		opts.Footers = footers
+	}
+	for i, r := range rows {
+		rows[i] = mapCells(r, markdownEscape)
+	}
+	return opts
+}
+
+// WithMarkdownLoose returns a copy of Options that writes FormatMarkdown as
+// a loose pipe table, without outer pipes or padding.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithMarkdownLoose()
+func (o Options) WithMarkdownLoose() Options {
+	o.MarkdownLoose = true
+	return o
+}
+
+// WithMarkdownCompactSeparator returns a copy of Options that writes the
+// FormatMarkdown separator row without spaces around the dashes.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithMarkdownCompactSeparator()
+func (o Options) WithMarkdownCompactSeparator() Options {
+	o.MarkdownCompactSeparator = true
+	return o
+}
@@ -0,0 +1,81 @@
+package tablewriter_test
+
+import (
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestMarkdownLoose(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		aligns  []tablewriter.Alignment
+		rows    [][]string
+		want    string
+	}{
+		{
+			"aligned",
+			[]string{"Name", "Age", "Role"},
+			[]tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignRight, tablewriter.AlignCenter},
+			[][]string{{"alice", "30", "a|b"}, {"bob"}},
+			"Name | Age | Role\n--- | ---: | :---:\nalice | 30 | a\\|b\nbob |  |\n",
+		},
+		{
+			"single column",
+			[]string{"Name"},
+			nil,
+			[][]string{{"alice"}},
+			"| Name\n| ---\n| alice\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:       tt.headers,
+				Alignments:    tt.aligns,
+				Format:        tablewriter.FormatMarkdown,
+				MarkdownLoose: true,
+			}
+			got, err := tablewriter.Render(opts, tt.rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownSeparator(t *testing.T) {
+	aligns := []tablewriter.Alignment{tablewriter.AlignCenter, tablewriter.AlignRight, tablewriter.AlignLeft}
+	tests := []struct {
+		name    string
+		loose   bool
+		compact bool
+		want    string
+	}{
+		{"default", false, false, "|   A   |    B | Name  |\n| :---: | ---: | ----- |\n|   x   |    1 | alice |\n"},
+		{"compact", false, true, "|   A   |    B | Name  |\n|:-----:|-----:|-------|\n|   x   |    1 | alice |\n"},
+		{"loose compact", true, true, "A | B | Name\n:---:|---:|---\nx | 1 | alice\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:                  []string{"A", "B", "Name"},
+				Alignments:               aligns,
+				Format:                   tablewriter.FormatMarkdown,
+				MarkdownLoose:            tt.loose,
+				MarkdownCompactSeparator: tt.compact,
+			}
+			got, err := tablewriter.Render(opts, [][]string{{"x", "1", "alice"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
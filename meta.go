@@ -0,0 +1,109 @@
+package tablewriter
+
+// RowMeta is arbitrary key/value metadata attached to a row, such as
+// "severity": "critical". It is never rendered itself; the MetaTransforms,
+// MetaFilter, and MetaRowClass hooks read it.
+type RowMeta map[string]string
+
+// WithMetaTransforms returns a copy of Options with the given
+// metadata-aware row transforms appended.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithMetaTransforms(func(row []string, meta tablewriter.RowMeta) []string {
+//	    if meta["severity"] == "critical" {
+//	        row[0] = "!" + row[0]
+//	    }
+//	    return row
+//	})
+func (o Options) WithMetaTransforms(f ...func(row []string, meta RowMeta) []string) Options {
+	o.MetaTransforms = append(append([]func([]string, RowMeta) []string(nil), o.MetaTransforms...), f...)
+	return o
+}
+
+// WithMetaFilter returns a copy of Options that renders only the rows for
+// which f returns true.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithMetaFilter(func(row []string, meta tablewriter.RowMeta) bool {
+//	    return meta["severity"] != "debug"
+//	})
+func (o Options) WithMetaFilter(f func(row []string, meta RowMeta) bool) Options {
+	o.MetaFilter = f
+	return o
+}
+
+// WithMetaRowClass returns a copy of Options that gives FormatHTML rows the
+// CSS class f returns.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithMetaRowClass(func(row []string, meta tablewriter.RowMeta) string {
+//	    return meta["severity"]
+//	})
+func (o Options) WithMetaRowClass(f func(row []string, meta RowMeta) string) Options {
+	o.MetaRowClass = f
+	return o
+}
+
+// AddRowWithMeta appends a row like AddRow and attaches meta to it. When
+// DuplicateKeyOverwrite replaces an existing row, meta replaces that row's
+// metadata.
+//
+// Example:
+//
+//	err := t.AddRowWithMeta(tablewriter.RowMeta{"severity": "critical"}, "disk", "97%")
+func (t *Table) AddRowWithMeta(meta RowMeta, cols ...string) error {
+	i, err := t.addRow(cols)
+	if err != nil {
+		return err
+	}
+	t.meta[i] = cloneMeta(meta)
+	return nil
+}
+
+// RowMeta returns a copy of the metadata attached to row i, or nil if the
+// row has none or does not exist.
+//
+// Example:
+//
+//	if t.RowMeta(0)["severity"] == "critical" { ... }
+func (t *Table) RowMeta(i int) RowMeta {
+	return cloneMeta(metaAt(t.meta, i))
+}
+
+// SetRowMeta replaces the metadata attached to row i.
+// Returns ErrRowOutOfRange if i is not an existing row.
+//
+// Example:
+//
+//	err := t.SetRowMeta(2, tablewriter.RowMeta{"severity": "ok"})
+func (t *Table) SetRowMeta(i int, meta RowMeta) error {
+	if i < 0 || i >= len(t.rows) {
+		return ErrRowOutOfRange
+	}
+	t.meta[i] = cloneMeta(meta)
+	t.widths.reset()
+	return nil
+}
+
+// metaAt returns meta[i], or nil if i is out of range.
+func metaAt(meta []RowMeta, i int) RowMeta {
+	if i >= 0 && i < len(meta) {
+		return meta[i]
+	}
+	return nil
+}
+
+// cloneMeta returns a copy of m.
+func cloneMeta(m RowMeta) RowMeta {
+	if m == nil {
+		return nil
+	}
+	out := make(RowMeta, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,81 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRowMeta(t *testing.T) {
+	flag := func(row []string, meta tablewriter.RowMeta) []string {
+		if meta["severity"] == "critical" {
+			row[0] = "!" + row[0]
+		}
+		return row
+	}
+	tbl := tablewriter.New(tablewriter.Options{
+		Format:         tablewriter.FormatCSV,
+		MetaTransforms: []func([]string, tablewriter.RowMeta) []string{flag},
+	})
+	tbl.AddRowWithMeta(tablewriter.RowMeta{"severity": "critical"}, "disk", "97%")
+	tbl.AddRow("cpu", "12%")
+
+	out, err := tbl.RenderErr()
+	if err != nil {
+		t.Fatalf("RenderErr() error = %v", err)
+	}
+	if want := "!disk,97%\ncpu,12%\n"; out != want {
+		t.Errorf("RenderErr() = %q, want %q", out, want)
+	}
+	if got := tbl.RowMeta(0)["severity"]; got != "critical" {
+		t.Errorf("RowMeta(0) severity = %q, want %q", got, "critical")
+	}
+	if got := tbl.RowMeta(1); got != nil {
+		t.Errorf("RowMeta(1) = %v, want nil", got)
+	}
+}
+
+func TestRowMetaOverwrite(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{
+		Headers:        []string{"Host", "Disk"},
+		UniqueKey:      "Host",
+		OnDuplicateKey: tablewriter.DuplicateKeyOverwrite,
+	})
+	tbl.AddRowWithMeta(tablewriter.RowMeta{"severity": "ok"}, "db", "40%")
+	tbl.AddRow("web", "12%")
+	if err := tbl.AddRowWithMeta(tablewriter.RowMeta{"severity": "critical"}, "db", "97%"); err != nil {
+		t.Fatalf("AddRowWithMeta() error = %v", err)
+	}
+	if got := tbl.RowMeta(0)["severity"]; got != "critical" {
+		t.Errorf("RowMeta(0) severity = %q, want %q", got, "critical")
+	}
+	if got := tbl.RowMeta(1); got != nil {
+		t.Errorf("RowMeta(1) = %v, want nil", got)
+	}
+}
+
+func TestMetaHooks(t *testing.T) {
+	opts := tablewriter.DefaultOptions().
+		WithHeaders("Check", "Value").
+		WithMetaFilter(func(row []string, meta tablewriter.RowMeta) bool { return meta["severity"] != "debug" }).
+		WithMetaRowClass(func(row []string, meta tablewriter.RowMeta) string { return meta["severity"] })
+	opts.Format = tablewriter.FormatHTML
+	opts.SortBy = "Check"
+	tbl := tablewriter.New(opts)
+	tbl.AddRowWithMeta(tablewriter.RowMeta{"severity": "critical"}, "disk", "97%")
+	tbl.AddRowWithMeta(tablewriter.RowMeta{"severity": "debug"}, "trace", "on")
+	tbl.AddRow("cpu", "12%")
+
+	out, err := tbl.RenderErr()
+	if err != nil {
+		t.Fatalf("RenderErr() error = %v", err)
+	}
+	want := "  <tbody>\n" +
+		"    <tr><td>cpu</td><td>12%</td></tr>\n" +
+		"    <tr class=\"critical\"><td>disk</td><td>97%</td></tr>\n" +
+		"  </tbody>\n"
+	if !strings.Contains(out, want) {
+		t.Errorf("RenderErr() = %s, want body %s", out, want)
+	}
+}
@@ -0,0 +1,85 @@
+package tablewriter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNestedKeyConflict is returned when dot-notation headers use the same
+// path as both a value and an object, e.g. "user" and "user.name".
+var ErrNestedKeyConflict = errors.New("tablewriter: conflicting nested JSON keys")
+
+// renderObjectJSON renders rows as a JSON array of objects, encoding typed
+// columns by ColumnTypes and, with NestedJSON, splitting each header on "."
+// to build nested objects.
+func renderObjectJSON(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	if len(opts.Headers) == 0 {
+		return "", ErrMissingHeaders
+	}
+	paths := make([][]string, len(opts.Headers))
+	for i, h := range opts.Headers {
+		paths[i] = []string{h}
+		if opts.NestedJSON {
+			paths[i] = strings.Split(h, ".")
+		}
+	}
+
+	out := make([]map[string]any, 0, len(rows))
+	for _, r := range rows {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		obj := map[string]any{}
+		for i, path := range paths {
+			v := ""
+			if i < len(r) {
+				v = r[i]
+			}
+			var cell any
+			if t := typeAt(opts, i); t != TypeString && t != TypeTime {
+				cell = t.jsonValue(v)
+			} else {
+				var err error
+				if cell, err = applyCellOpts(v, opts); err != nil {
+					return "", err
+				}
+			}
+			if err := setPath(obj, path, cell); err != nil {
+				return "", fmt.Errorf("%w: %q", err, opts.Headers[i])
+			}
+		}
+		out = append(out, obj)
+	}
+
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// setPath stores v in obj under the nested key path, creating intermediate
+// objects as needed.
+func setPath(obj map[string]any, path []string, v any) error {
+	for _, k := range path[:len(path)-1] {
+		switch child := obj[k].(type) {
+		case nil:
+			m := map[string]any{}
+			obj[k] = m
+			obj = m
+		case map[string]any:
+			obj = child
+		default:
+			return ErrNestedKeyConflict
+		}
+	}
+	last := path[len(path)-1]
+	if _, exists := obj[last]; exists {
+		return ErrNestedKeyConflict
+	}
+	obj[last] = v
+	return nil
+}
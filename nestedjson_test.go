@@ -0,0 +1,57 @@
+package tablewriter_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestNestedJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		row     []string
+		want    any
+		wantErr error
+	}{{
+		"nested",
+		[]string{"id", "user.name", "user.address.city"},
+		[]string{"1", "Alice", "NYC"},
+		[]any{map[string]any{
+			"id": "1",
+			"user": map[string]any{
+				"name":    "Alice",
+				"address": map[string]any{"city": "NYC"},
+			},
+		}},
+		nil,
+	}, {
+		"conflict",
+		[]string{"user", "user.name"},
+		[]string{"x", "Alice"},
+		nil,
+		tablewriter.ErrNestedKeyConflict,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Headers: tt.headers, Format: tablewriter.FormatJSON, NestedJSON: true}
+			out, err := tablewriter.Render(opts, [][]string{tt.row})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Render() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			var got any
+			if err := json.Unmarshal([]byte(out), &got); err != nil {
+				t.Fatalf("Render() produced invalid JSON: %v\n%s", err, out)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Render() = %s, want %v", out, tt.want)
+			}
+		})
+	}
+}
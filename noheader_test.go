@@ -0,0 +1,53 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestNoHeader(t *testing.T) {
+	tests := []struct {
+		format     tablewriter.Format
+		wantHeader bool
+	}{
+		{tablewriter.FormatSimple, false},
+		{tablewriter.FormatCSV, false},
+		{tablewriter.FormatHTML, false},
+		{tablewriter.FormatMarkdown, true},
+		{tablewriter.FormatJSON, true},
+		{tablewriter.FormatList, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format.String(), func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:  []string{"Name", "Score"},
+				Format:   tt.format,
+				NoHeader: true,
+				SortBy:   "Score",
+			}
+			got, err := tablewriter.Render(opts, [][]string{{"bob", "2"}, {"alice", "1"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if strings.Contains(got, "Name") != tt.wantHeader {
+				t.Errorf("Render() = %q, header shown = %v, want %v", got, !tt.wantHeader, tt.wantHeader)
+			}
+			if strings.Index(got, "alice") > strings.Index(got, "bob") {
+				t.Errorf("Render() = %q, want rows sorted by Score", got)
+			}
+		})
+	}
+}
+
+func TestNoHeaderCSV(t *testing.T) {
+	opts := tablewriter.Options{Headers: []string{"Name"}, Format: tablewriter.FormatCSV, NoHeader: true}
+	got, err := tablewriter.Render(opts, [][]string{{"alice"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if got != "alice\n" {
+		t.Errorf("Render() = %q, want %q", got, "alice\n")
+	}
+}
@@ -0,0 +1,72 @@
+package tablewriter
+
+// Null marks a cell as explicitly NULL, as opposed to an empty string. It
+// renders like an empty cell unless Options.ExplicitNulls is set, in which
+// case only Null cells get the null placeholder.
+//
+// Example:
+//
+//	t.AddRow("alice", tablewriter.Null)
+const Null = "\x00"
+
+// AddRowPtr appends a row like AddRow, with nil cells recorded as Null, so
+// values scanned from nullable database columns keep their NULLs.
+//
+// Example:
+//
+//	var email *string // NULL in the database
+//	err := t.AddRowPtr(&name, email)
+func (t *Table) AddRowPtr(cols ...*string) error {
+	row := make([]string, len(cols))
+	for i, c := range cols {
+		if c == nil {
+			row[i] = Null
+		} else {
+			row[i] = *c
+		}
+	}
+	return t.AddRow(row...)
+}
+
+// resolveNulls replaces Null cells in rows. Without opts.ExplicitNulls they
+// become empty cells. With it they become the column's null placeholder and
+// the placeholders are cleared from the returned Options, so empty strings
+// render as empty; FormatCSV keeps Null cells for the CSVNull token.
+func resolveNulls(opts Options, rows [][]string) Options {
+	keep := opts.ExplicitNulls && opts.Format == FormatCSV && opts.CSVNull != ""
+	for _, r := range rows {
+		for i, c := range r {
+			switch {
+			case c != Null || keep:
+			case opts.ExplicitNulls:
+				r[i] = nullPlaceholder(opts, i)
+			default:
+				r[i] = ""
+			}
+		}
+	}
+	if opts.ExplicitNulls {
+		opts.NullPlaceholder = ""
+		opts.NullPlaceholders = nil
+	}
+	return opts
+}
+
+// nullPlaceholder returns the null placeholder for column col.
+func nullPlaceholder(opts Options, col int) string {
+	if p := cellAt(opts.NullPlaceholders, col); p != "" {
+		return p
+	}
+	return opts.NullPlaceholder
+}
+
+// WithExplicitNulls returns a copy of Options that gives null placeholders
+// only to cells marked Null, leaving empty strings empty.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithNullPlaceholder("NULL").WithExplicitNulls()
+func (o Options) WithExplicitNulls() Options {
+	o.ExplicitNulls = true
+	return o
+}
@@ -0,0 +1,62 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestExplicitNulls(t *testing.T) {
+	empty := ""
+	name := "alice"
+	tests := []struct {
+		name     string
+		explicit bool
+		csvNull  string
+		want     string
+	}{
+		{"placeholder for nulls only", true, "", "Name,Email\nalice,N/A\nalice,\n"},
+		{"placeholder for all empties", false, "", "Name,Email\nalice,N/A\nalice,N/A\n"},
+		{"csv null token for nulls only", true, `\N`, "Name,Email\nalice,\\N\nalice,\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := tablewriter.New(tablewriter.Options{
+				Headers:         []string{"Name", "Email"},
+				Format:          tablewriter.FormatCSV,
+				NullPlaceholder: "N/A",
+				ExplicitNulls:   tt.explicit,
+				CSVNull:         tt.csvNull,
+			})
+			if err := tbl.AddRowPtr(&name, nil); err != nil {
+				t.Fatalf("AddRowPtr() error = %v", err)
+			}
+			if err := tbl.AddRowPtr(&name, &empty); err != nil {
+				t.Fatalf("AddRowPtr() error = %v", err)
+			}
+			got, err := tbl.RenderErr()
+			if err != nil {
+				t.Fatalf("RenderErr() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RenderErr() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullSentinelNeverRendered(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers:    []string{"Name", "Size"},
+		Format:     tablewriter.FormatMarkdown,
+		Formatters: []tablewriter.Formatter{nil, strings.ToUpper},
+	}
+	got, err := tablewriter.Render(opts, [][]string{{"a", tablewriter.Null}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if strings.Contains(got, tablewriter.Null) {
+		t.Errorf("Render() = %q, contains the Null sentinel", got)
+	}
+}
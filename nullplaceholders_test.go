@@ -0,0 +1,37 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestNullPlaceholders(t *testing.T) {
+	tests := []struct {
+		name         string
+		global       string
+		placeholders []string
+		want         string
+	}{
+		{"per column", "", []string{"—", "0", "n/a"}, "—,0,n/a\n"},
+		{"fallback to global", "?", []string{"—", ""}, "—,?,?\n"},
+		{"global only", "-", nil, "-,-,-\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Format:           tablewriter.FormatCSV,
+				NullPlaceholder:  tt.global,
+				NullPlaceholders: tt.placeholders,
+			}
+			out, err := tablewriter.Render(opts, [][]string{{"", "", ""}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("Render() = %q, want it to contain %q", out, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package tablewriter
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Notation selects how NumberFormatter writes numbers.
+type Notation int
+
+const (
+	NotationFixed       Notation = iota // NotationFixed writes a fixed number of decimal places, e.g. 3.14.
+	NotationScientific                  // NotationScientific writes a mantissa and exponent, e.g. 3.14e+00.
+	NotationSignificant                 // NotationSignificant rounds to a number of significant figures, e.g. 3.14 or 1.23e+08.
+)
+
+// NumberFormatter returns a Formatter that rounds numeric cells to precision
+// in the given notation: decimal places for NotationFixed and
+// NotationScientific, significant figures for NotationSignificant.
+// Non-numeric cells are left unchanged.
+//
+// Example:
+//
+//	opts := tablewriter.Options{Columns: []tablewriter.Column{
+//	    {Name: "Sample"},
+//	    {Name: "Mean", Alignment: tablewriter.AlignRight, Formatter: tablewriter.NumberFormatter(3, tablewriter.NotationFixed)},
+//	}}
+func NumberFormatter(precision int, notation Notation) Formatter {
+	return func(v string) string {
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return v
+		}
+		switch notation {
+		case NotationScientific:
+			return strconv.FormatFloat(n, 'e', precision, 64)
+		case NotationSignificant:
+			return strconv.FormatFloat(n, 'g', max(precision, 1), 64)
+		default:
+			return strconv.FormatFloat(n, 'f', precision, 64)
+		}
+	}
+}
+
+// AccountingFormatter returns a Formatter that writes numeric cells in
+// accounting style: precision decimal places, thousands separated by
+// commas, and negatives in parentheses, e.g. "(1,234.00)". Other values get
+// a trailing space in place of the closing parenthesis, so digits stay
+// lined up in a right-aligned column. Non-numeric cells are left unchanged.
+//
+// Example:
+//
+//	opts := tablewriter.Options{Columns: []tablewriter.Column{
+//	    {Name: "Account"},
+//	    {Name: "Balance", Alignment: tablewriter.AlignRight, Formatter: tablewriter.AccountingFormatter(2)},
+//	}}
+func AccountingFormatter(precision int) Formatter {
+	return func(v string) string {
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return v
+		}
+		s := strconv.FormatFloat(n, 'f', precision, 64)
+		neg := strings.HasPrefix(s, "-")
+		s = strings.TrimPrefix(s, "-")
+		if strings.Trim(s, "0.") == "" {
+			neg = false // rounds to zero
+		}
+		if neg {
+			return "(" + groupThousands(s) + ")"
+		}
+		return groupThousands(s) + " "
+	}
+}
+
+// groupThousands inserts commas between groups of three digits in the
+// integer part of the unsigned decimal s.
+func groupThousands(s string) string {
+	intPart, frac, hasFrac := strings.Cut(s, ".")
+	var b strings.Builder
+	for i, d := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(d)
+	}
+	if hasFrac {
+		b.WriteString("." + frac)
+	}
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package tablewriter_test
+
+import (
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestNumberFormatter(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision int
+		notation  tablewriter.Notation
+		in        string
+		want      string
+	}{
+		{"fixed rounds", 2, tablewriter.NotationFixed, "3.14159", "3.14"},
+		{"fixed pads", 3, tablewriter.NotationFixed, "2", "2.000"},
+		{"fixed zero places", 0, tablewriter.NotationFixed, "2.5001", "3"},
+		{"scientific", 2, tablewriter.NotationScientific, "123456", "1.23e+05"},
+		{"significant small", 3, tablewriter.NotationSignificant, "0.000123456", "0.000123"},
+		{"significant large", 3, tablewriter.NotationSignificant, "123456789", "1.23e+08"},
+		{"not a number", 2, tablewriter.NotationFixed, "N/A", "N/A"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tablewriter.NumberFormatter(tt.precision, tt.notation)(tt.in); got != tt.want {
+				t.Errorf("NumberFormatter(%d, %v)(%q) = %q, want %q", tt.precision, tt.notation, tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccountingFormatter(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision int
+		in        string
+		want      string
+	}{
+		{"negative", 2, "-1234", "(1,234.00)"},
+		{"positive", 2, "1234567.891", "1,234,567.89 "},
+		{"small", 2, "12.5", "12.50 "},
+		{"no places", 0, "-999.6", "(1,000)"},
+		{"rounds to zero", 2, "-0.001", "0.00 "},
+		{"not a number", 2, "N/A", "N/A"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tablewriter.AccountingFormatter(tt.precision)(tt.in); got != tt.want {
+				t.Errorf("AccountingFormatter(%d)(%q) = %q, want %q", tt.precision, tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package tablewriter_test
+
+import (
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestOmitEmptyColumns(t *testing.T) {
+	tests := []struct {
+		name        string
+		omit        bool
+		placeholder string
+		rows        [][]string
+		want        string
+	}{
+		{"disabled", false, "", [][]string{{"a", "", "x"}}, "ID,Nick,Email\na,,x\n"},
+		{"empty column", true, "", [][]string{{"a", "", "x"}, {"b", "", ""}}, "ID,Email\na,x\nb,\n"},
+		{"placeholder counts as empty", true, "-", [][]string{{"a", "-", "x"}, {"b", "", "y"}}, "ID,Email\na,x\nb,y\n"},
+		{"no rows keeps headers", true, "", [][]string{}, "ID,Nick,Email\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:          []string{"ID", "Nick", "Email"},
+				Format:           tablewriter.FormatCSV,
+				OmitEmptyColumns: tt.omit,
+				NullPlaceholder:  tt.placeholder,
+			}
+			out, err := tablewriter.Render(opts, tt.rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
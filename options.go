@@ -1,4 +1,3 @@
-
 // Package tablewriter provides a writer for table-based data with various format options.
 //
 // Users can configure table writer options using the DefaultOptions function and
@@ -21,7 +20,7 @@ var ErrInvalidColumnWidth = errors.New("invalid column width")
 // ValidFormat determines if a given format is valid.
 func ValidFormat(f Format) bool {
 	switch f {
-	case FormatPlain, FormatMarkdown, FormatCSV:
+	case FormatPlain, FormatMarkdown, FormatCSV, FormatJSON, FormatSimple, FormatList, FormatHTML:
 		return true
 	default:
 		return false
@@ -42,18 +41,6 @@ func DefaultOptions() Options {
 	}
 }
 
-// Options represents a set of configurable options for a table writer.
-//
-// The Zero value of Options is invalid and will cause errors if used directly.
-type Options struct {
-	Format          Format
-	Headers         []string
-	Alignments      []Alignment
-	MaxColumnWidth  int
-	NullPlaceholder string
-	StrictColumnCount bool
-}
-
 // WithHeaders returns a copy of Options with the given headers set.
 //
 // Example:
@@ -131,3 +118,339 @@ func NewTableWriter(ctx context.Context, w io.Writer, opts Options) (io.Writer,
 	// implement table writer logic here
 	return w, nil
 }
+
+// WithWideColumns returns a copy of Options with the given headers marked as
+// wide-only columns, hidden unless Wide is set.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithWideColumns("IP", "Node")
+func (o Options) WithWideColumns(headers ...string) Options {
+	o.WideColumns = headers
+	return o
+}
+
+// WithWide returns a copy of Options with wide output enabled or disabled.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithWide(*outputFlag == "wide")
+func (o Options) WithWide(wide bool) Options {
+	o.Wide = wide
+	return o
+}
+
+// WithEscapeFormulas returns a copy of Options with CSV formula escaping enabled.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithEscapeFormulas()
+func (o Options) WithEscapeFormulas() Options {
+	o.EscapeFormulas = true
+	return o
+}
+
+// WithDuplicateHeaders returns a copy of Options with the given duplicate header mode.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithDuplicateHeaders(tablewriter.DuplicateError)
+func (o Options) WithDuplicateHeaders(m DuplicateHeaderMode) Options {
+	o.DuplicateHeaders = m
+	return o
+}
+
+// WithNestedJSON returns a copy of Options with dot-notation header nesting enabled for FormatJSON.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithHeaders("user.name", "user.city").WithNestedJSON()
+func (o Options) WithNestedJSON() Options {
+	o.NestedJSON = true
+	return o
+}
+
+// WithSanitizeUTF8 returns a copy of Options that replaces invalid UTF-8 with marker.
+// An empty marker uses U+FFFD.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithSanitizeUTF8("?")
+func (o Options) WithSanitizeUTF8(marker string) Options {
+	o.SanitizeUTF8 = true
+	o.InvalidUTF8Marker = marker
+	return o
+}
+
+// WithEmptyMessage returns a copy of Options with the message shown for tables with no rows.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithEmptyMessage("(no rows)")
+func (o Options) WithEmptyMessage(msg string) Options {
+	o.EmptyMessage = msg
+	return o
+}
+
+// WithHeaderMaxWidth returns a copy of Options with a header width limit separate from MaxColumnWidth.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithHeaderMaxWidth(30)
+func (o Options) WithHeaderMaxWidth(w int) (Options, error) {
+	if w < 0 {
+		return o, fmt.Errorf("invalid header max width: %w", ErrInvalidColumnWidth)
+	}
+	o.HeaderMaxWidth = w
+	return o, nil
+}
+
+// WithExemptHeadersFromTruncation returns a copy of Options where MaxColumnWidth applies to data cells only.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithExemptHeadersFromTruncation()
+func (o Options) WithExemptHeadersFromTruncation() Options {
+	o.ExemptHeadersFromTruncation = true
+	return o
+}
+
+// WithNullPlaceholders returns a copy of Options with per-column null placeholders.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithNullPlaceholders("—", "0", "n/a")
+func (o Options) WithNullPlaceholders(p ...string) Options {
+	o.NullPlaceholders = p
+	return o
+}
+
+// WithSplitWidth returns a copy of Options that splits tables wider than w into stacked chunks.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithSplitWidth(80)
+func (o Options) WithSplitWidth(w int) (Options, error) {
+	if w < 0 {
+		return o, fmt.Errorf("invalid split width: %w", ErrInvalidColumnWidth)
+	}
+	o.SplitWidth = w
+	return o, nil
+}
+
+// WithColumnPriorities returns a copy of Options that drops low-priority
+// columns to fit the table within width.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithColumnPriorities(80, 10, 5, 1)
+func (o Options) WithColumnPriorities(width int, priorities ...int) Options {
+	o.ResponsiveWidth = width
+	o.ColumnPriorities = priorities
+	return o
+}
+
+// WithFormatters returns a copy of Options with the given per-column formatters.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithFormatters(nil, tablewriter.BoolCheck)
+func (o Options) WithFormatters(f ...Formatter) Options {
+	o.Formatters = f
+	return o
+}
+
+// WithTruncateUnit returns a copy of Options with the given truncation unit.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithTruncateUnit(tablewriter.TruncateBytes)
+func (o Options) WithTruncateUnit(u TruncateUnit) Options {
+	o.TruncateUnit = u
+	return o
+}
+
+// WithWidthFunc returns a copy of Options that measures text with f for column layout.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithWidthFunc(runewidth.StringWidth)
+func (o Options) WithWidthFunc(f func(string) int) Options {
+	o.WidthFunc = f
+	return o
+}
+
+// WithRowTransforms returns a copy of Options with the given render-time row transforms appended.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithRowTransforms(func(r []string) []string {
+//	    r[1] = "***"
+//	    return r
+//	})
+func (o Options) WithRowTransforms(f ...func([]string) []string) Options {
+	o.RowTransforms = append(append([]func([]string) []string(nil), o.RowTransforms...), f...)
+	return o
+}
+
+// WithPostRender returns a copy of Options with the given output hooks appended.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithPostRender(func(s string) string {
+//	    return "```\n" + s + "```\n"
+//	})
+func (o Options) WithPostRender(f ...func(string) string) Options {
+	o.PostRender = append(append([]func(string) string(nil), o.PostRender...), f...)
+	return o
+}
+
+// WithMaxTableWidth returns a copy of Options that shrinks columns so the whole table fits in w.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithMaxTableWidth(80)
+func (o Options) WithMaxTableWidth(w int) (Options, error) {
+	if w < 0 {
+		return o, fmt.Errorf("invalid max table width: %w", ErrInvalidColumnWidth)
+	}
+	o.MaxTableWidth = w
+	return o, nil
+}
+
+// WithColumnPercents returns a copy of Options with per-column widths given as percentages.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithColumnPercents(30, 70)
+func (o Options) WithColumnPercents(p ...int) Options {
+	o.ColumnPercents = p
+	return o
+}
+
+// WithWrapCells returns a copy of Options that wraps long cells at MaxColumnWidth instead of truncating.
+//
+// Example:
+//
+//	opts, _ := tablewriter.DefaultOptions().WithMaxColumnWidth(30)
+//	opts = opts.WithWrapCells(true)
+func (o Options) WithWrapCells(hyphenate bool) Options {
+	o.WrapCells = true
+	o.Hyphenate = hyphenate
+	return o
+}
+
+// WithWrapHeaders returns a copy of Options that wraps long headers to their column's data width.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithWrapHeaders()
+func (o Options) WithWrapHeaders() Options {
+	o.WrapHeaders = true
+	return o
+}
+
+// WithOmitEmptyColumns returns a copy of Options that drops columns with no data at render time.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithOmitEmptyColumns()
+func (o Options) WithOmitEmptyColumns() Options {
+	o.OmitEmptyColumns = true
+	return o
+}
+
+// WithCollapseRepeats returns a copy of Options with per-column repeat collapsing.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithCollapseRepeats(true, true, false)
+func (o Options) WithCollapseRepeats(c ...bool) Options {
+	o.CollapseRepeats = c
+	return o
+}
+
+// WithHeaderLabels returns a copy of Options with display labels for header keys.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithHeaderLabels(map[string]string{"created_at": "Created"})
+func (o Options) WithHeaderLabels(labels map[string]string) Options {
+	o.HeaderLabels = labels
+	return o
+}
+
+// WithHeaderCase returns a copy of Options that converts header keys for display with f.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithHeaderCase(tablewriter.TitleCase)
+func (o Options) WithHeaderCase(f func(string) string) Options {
+	o.HeaderCase = f
+	return o
+}
+
+// WithMaxColumnWidths returns a copy of Options with per-column truncation
+// widths. Returns ErrInvalidColumnWidth if any width is negative.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithMaxColumnWidths(0, 40, 12)
+func (o Options) WithMaxColumnWidths(w ...int) (Options, error) {
+	for _, n := range w {
+		if n < 0 {
+			return o, fmt.Errorf("invalid max column width %d: %w", n, ErrInvalidColumnWidth)
+		}
+	}
+	o.MaxColumnWidths = w
+	return o, nil
+}
+
+// WithNoColor returns a copy of Options that strips ANSI styling from
+// headers and cells.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithNoColor()
+func (o Options) WithNoColor() Options {
+	o.NoColor = true
+	return o
+}
+
+// WithMinRows returns a copy of Options that pads text-format tables with
+// blank rows up to n. Returns ErrInvalidOptions if n is negative.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithMinRows(10)
+func (o Options) WithMinRows(n int) (Options, error) {
+	if n < 0 {
+		return o, fmt.Errorf("invalid min rows %d: %w", n, ErrInvalidOptions)
+	}
+	o.MinRows = n
+	return o, nil
+}
+
+// WithNoHeader returns a copy of Options that omits the header row from
+// formats that allow it.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithNoHeader()
+func (o Options) WithNoHeader() Options {
+	o.NoHeader = true
+	return o
+}
+
+// WithRowFilter returns a copy of Options that renders only the rows for
+// which f returns true.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithRowFilter(func(row []string) bool {
+//	    return row[2] == "error"
+//	})
+func (o Options) WithRowFilter(f func(row []string) bool) Options {
+	o.RowFilter = f
+	return o
+}
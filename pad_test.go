@@ -0,0 +1,69 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestMinRows(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      tablewriter.Options
+		rows      [][]string
+		wantLines int
+	}{
+		{
+			"padded",
+			tablewriter.Options{Format: tablewriter.FormatMarkdown, Headers: []string{"A", "B"}, MinRows: 3},
+			[][]string{{"1", "2"}},
+			5,
+		},
+		{
+			"already enough",
+			tablewriter.Options{Format: tablewriter.FormatMarkdown, Headers: []string{"A"}, MinRows: 1},
+			[][]string{{"1"}, {"2"}},
+			4,
+		},
+		{
+			"csv unchanged",
+			tablewriter.Options{Format: tablewriter.FormatCSV, MinRows: 3},
+			[][]string{{"1"}},
+			1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := tablewriter.Render(tt.opts, tt.rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got := strings.Count(strings.TrimSuffix(out, "\n"), "\n") + 1; got != tt.wantLines {
+				t.Errorf("Render() has %d lines, want %d:\n%s", got, tt.wantLines, out)
+			}
+		})
+	}
+}
+
+func TestMinRowsPlaceholder(t *testing.T) {
+	opts := tablewriter.Options{Format: tablewriter.FormatMarkdown, Headers: []string{"A"}, MinRows: 3, NullPlaceholder: "n/a"}
+	out, err := tablewriter.Render(opts, [][]string{{""}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if got := strings.Count(out, "n/a"); got != 1 {
+		t.Errorf("Render() has %d placeholders, want 1 (padding rows stay blank):\n%s", got, out)
+	}
+}
+
+func TestWithMinRows(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithMinRows(-1); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithMinRows(-1) error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+	opts, err := tablewriter.DefaultOptions().WithMinRows(3)
+	if err != nil || opts.MinRows != 3 {
+		t.Errorf("WithMinRows(3) = %d, %v", opts.MinRows, err)
+	}
+}
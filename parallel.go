@@ -0,0 +1,198 @@
+package tablewriter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// minParallelChunk is the fewest rows each worker gets; smaller tables are
+// rendered sequentially.
+const minParallelChunk = 1000
+
+// errMultilineCell makes renderParallel fall back to the sequential
+// renderer for rows whose cells span several lines.
+var errMultilineCell = errors.New("multi-line cell")
+
+// canRenderParallel reports whether rows can be rendered by renderParallel:
+// Workers allows it, there are enough rows for two chunks, and the format
+// writes one line per row.
+func canRenderParallel(opts Options, rows [][]string) bool {
+	if opts.Workers < 2 || len(rows) < 2*minParallelChunk || opts.WrapCells {
+		return false
+	}
+	switch opts.Format {
+	case FormatPlain, FormatSimple:
+		return true
+	case FormatMarkdown:
+		return !customMarkdown(opts)
+	case FormatCSV:
+		return true
+	default:
+		return false
+	}
+}
+
+// renderParallel measures and renders rows in chunks on up to opts.Workers
+// goroutines and stitches the chunks between the header and trailer lines
+// of the format's own renderer. Text rows are laid out with formatLine in
+// the widths of the whole table, as RenderAppend does.
+func renderParallel(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	chunks := chunkRows(rows, opts.Workers)
+	var widths []int
+	if opts.Format != FormatCSV {
+		var err error
+		if widths, err = parallelWidths(ctx, opts, rows, chunks); err != nil {
+			return "", err
+		}
+	}
+
+	bodies := make([]string, len(chunks))
+	errs := make([]error, len(chunks))
+	var wg sync.WaitGroup
+	for i, c := range chunks {
+		wg.Add(1)
+		go func(i int, chunk [][]string) {
+			defer wg.Done()
+			bodies[i], errs[i] = renderChunk(ctx, opts, chunk, widths)
+		}(i, c)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if errors.Is(err, errMultilineCell) {
+			return renderFormat(ctx, opts, rows)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	body := strings.Join(bodies, "")
+
+	if opts.Format == FormatCSV {
+		head, err := renderFormat(ctx, opts, nil)
+		if err != nil {
+			return "", err
+		}
+		return head + body, nil
+	}
+	// Render the header and borders around a filler row as wide as every
+	// column, then put the body in the filler row's place.
+	filler := make([]string, len(widths))
+	for i, w := range widths {
+		filler[i] = padding(w)
+	}
+	frame, err := renderFormat(ctx, opts, [][]string{filler})
+	if err != nil {
+		return "", err
+	}
+	lines := strings.Split(strings.TrimSuffix(frame, "\n"), "\n")
+	at := len(lines) - 1
+	if opts.Format == FormatPlain {
+		at-- // bottom border
+	}
+	if at < 0 {
+		return frame, nil
+	}
+	tail := strings.Join(lines[at+1:], "\n")
+	if tail != "" {
+		tail += "\n"
+	}
+	head := strings.Join(lines[:at], "\n")
+	if head != "" {
+		head += "\n"
+	}
+	return head + body + tail, nil
+}
+
+// chunkRows splits rows into at most workers chunks of at least
+// minParallelChunk rows.
+func chunkRows(rows [][]string, workers int) [][][]string {
+	n := min(workers, len(rows)/minParallelChunk)
+	size := (len(rows) + n - 1) / n
+	chunks := make([][][]string, 0, n)
+	for start := 0; start < len(rows); start += size {
+		chunks = append(chunks, rows[start:min(start+size, len(rows))])
+	}
+	return chunks
+}
+
+// parallelWidths returns the column widths of rows, measuring chunks
+// concurrently unless a width cache is in use.
+func parallelWidths(ctx context.Context, opts Options, rows [][]string, chunks [][][]string) ([]int, error) {
+	if opts.widthScope != nil {
+		return colWidths(ctx, opts, rows)
+	}
+	parts := make([][]int, len(chunks))
+	var wg sync.WaitGroup
+	for i, c := range chunks {
+		wg.Add(1)
+		go func(i int, chunk [][]string) {
+			defer wg.Done()
+			parts[i] = measureColumns(opts, chunk)
+		}(i, c)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var widths []int
+	for _, p := range parts {
+		for i, w := range p {
+			if i >= len(widths) {
+				widths = append(widths, w)
+			} else {
+				widths[i] = max(widths[i], w)
+			}
+		}
+	}
+	return widths, nil
+}
+
+// renderChunk renders the lines of one chunk of rows: CSV records, or text
+// lines laid out in widths.
+func renderChunk(ctx context.Context, opts Options, rows [][]string, widths []int) (string, error) {
+	if opts.Format == FormatCSV {
+		excel := opts.CSVDialect == CSVExcel
+		opts.Headers = nil
+		out, err := renderFormat(ctx, opts, rows)
+		if excel {
+			_, out, _ = strings.Cut(out, "\n") // drop the repeated "sep=" line
+		}
+		return out, err
+	}
+	var b strings.Builder
+	for _, r := range rows {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		cells := make([]string, len(r))
+		for i, c := range r {
+			c, err := applyCellOpts(c, opts)
+			if err != nil {
+				return "", err
+			}
+			if strings.ContainsAny(c, "\r\n") {
+				return "", errMultilineCell
+			}
+			cells[i] = c
+		}
+		b.WriteString(formatLine(opts.Format, cells, widths, opts.Alignments, opts) + "\n")
+	}
+	return b.String(), nil
+}
+
+// WithWorkers returns a copy of Options that renders large text and CSV
+// tables on up to n goroutines. Returns ErrInvalidOptions if n is negative.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithWorkers(runtime.GOMAXPROCS(0))
+func (o Options) WithWorkers(n int) (Options, error) {
+	if n < 0 {
+		return o, fmt.Errorf("invalid workers %d: %w", n, ErrInvalidOptions)
+	}
+	o.Workers = n
+	return o, nil
+}
@@ -0,0 +1,82 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestWorkersMatchSequential(t *testing.T) {
+	rows := make([][]string, 4321)
+	for i := range rows {
+		rows[i] = []string{fmt.Sprint(i), fmt.Sprintf("item %d", i*i), ""}
+	}
+	rows[4000][2] = "a|b"
+	tests := []struct {
+		name string
+		opts tablewriter.Options
+	}{
+		{"csv", tablewriter.Options{Format: tablewriter.FormatCSV}},
+		{"csv excel", tablewriter.Options{Format: tablewriter.FormatCSV, CSVDialect: tablewriter.CSVExcel, CSVDelimiter: ';'}},
+		{"markdown", tablewriter.Options{Format: tablewriter.FormatMarkdown}},
+		{"markdown right aligned", tablewriter.Options{Format: tablewriter.FormatMarkdown, Alignments: []tablewriter.Alignment{tablewriter.AlignRight}}},
+		{"plain", tablewriter.Options{Format: tablewriter.FormatPlain}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Headers = []string{"ID", "Name", "Note"}
+			want, err := tablewriter.Render(tt.opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			tt.opts.Workers = 4
+			got, err := tablewriter.Render(tt.opts, rows)
+			if err != nil {
+				t.Fatalf("Render() with Workers error = %v", err)
+			}
+			if got != want {
+				t.Errorf("Render() with Workers differs from sequential output:\n%.300q\nwant\n%.300q", got, want)
+			}
+		})
+	}
+}
+
+func TestWorkersTextLayout(t *testing.T) {
+	rows := make([][]string, 2500)
+	for i := range rows {
+		rows[i] = []string{fmt.Sprint(i), strings.Repeat("x", i%13)}
+	}
+	opts := tablewriter.Options{
+		Format:     tablewriter.FormatSimple,
+		Headers:    []string{"ID", "Data"},
+		Alignments: []tablewriter.Alignment{tablewriter.AlignRight},
+		Workers:    3,
+	}
+	out, err := tablewriter.Render(opts, rows)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	body := lines[len(lines)-len(rows):]
+	for i, l := range body {
+		if want := fmt.Sprintf("%4d  %-12s", i, strings.Repeat("x", i%13)); l != want {
+			t.Fatalf("line %d = %q, want %q", i, l, want)
+		}
+	}
+	if lines[0] != "  ID  Data        " {
+		t.Errorf("first line = %q, want the header", lines[0])
+	}
+}
+
+func TestWithWorkers(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithWorkers(-1); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithWorkers(-1) error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+	opts, err := tablewriter.DefaultOptions().WithWorkers(4)
+	if err != nil || opts.Workers != 4 {
+		t.Errorf("WithWorkers(4) = %d, %v", opts.Workers, err)
+	}
+}
@@ -0,0 +1,206 @@
+package tablewriter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrParseTable is returned when ParseMarkdown or ParsePlain cannot read a
+// table from their input.
+var ErrParseTable = errors.New("tablewriter: cannot parse table")
+
+// ParseMarkdown reads a Markdown pipe table, such as one rendered with
+// FormatMarkdown, back into a Table with FormatMarkdown, the table's
+// headers, the alignments given by its delimiter row, and its rows. Leading
+// and trailing pipes are optional, cells are trimmed, and escaped pipes
+// ("\|") are unescaped. Blank lines around the table are ignored.
+//
+// Example:
+//
+//	t, err := tablewriter.ParseMarkdown(section)
+//	t.AddRow("carol", "41")
+//	updated := t.Render()
+func ParseMarkdown(s string) (*Table, error) {
+	lines := tableLines(s)
+	if len(lines) < 2 {
+		return nil, fmt.Errorf("%w: missing header or delimiter row", ErrParseTable)
+	}
+	headers := splitMarkdownRow(lines[0])
+	seps := splitMarkdownRow(lines[1])
+	aligns := make([]Alignment, len(seps))
+	for i, sep := range seps {
+		a, ok := markdownAlignment(sep)
+		if !ok {
+			return nil, fmt.Errorf("%w: line 2: invalid delimiter cell %q", ErrParseTable, sep)
+		}
+		aligns[i] = a
+	}
+	if len(seps) != len(headers) {
+		return nil, fmt.Errorf("%w: delimiter row has %d cells, header has %d", ErrParseTable, len(seps), len(headers))
+	}
+	t := New(Options{Format: FormatMarkdown, Headers: headers, Alignments: aligns})
+	for _, l := range lines[2:] {
+		if err := t.AddRow(splitMarkdownRow(l)...); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// ParsePlain reads a box-drawn table, such as one rendered with
+// FormatPlain, back into a Table with FormatPlain, the table's headers, its
+// rows, and alignments inferred from how cells are padded. The lines above
+// the first rule beneath the border are the header (several lines, as
+// written by WrapHeaders, are joined with spaces); a table with no such
+// rule has no headers. Later rules, such as the one above footers, are
+// skipped, and every other line is read as one row.
+//
+// Example:
+//
+//	t, err := tablewriter.ParsePlain(golden)
+//	t.SetRow(0, "alice", "31")
+func ParsePlain(s string) (*Table, error) {
+	var content [][]string
+	headerLines := -1
+	for n, l := range tableLines(s) {
+		if !strings.HasPrefix(l, "│") {
+			if len(content) > 0 && headerLines < 0 {
+				headerLines = len(content)
+			}
+			continue
+		}
+		if !strings.HasSuffix(l, "│") || len(l) < 2*len("│") {
+			return nil, fmt.Errorf("%w: line %d: unterminated row", ErrParseTable, n+1)
+		}
+		content = append(content, strings.Split(l[len("│"):len(l)-len("│")], "│"))
+	}
+	if headerLines == len(content) {
+		headerLines = -1 // only the bottom border follows
+	}
+
+	var headers []string
+	rows := content
+	if headerLines > 0 {
+		for _, line := range content[:headerLines] {
+			for i, c := range line {
+				c = strings.TrimSpace(c)
+				switch {
+				case i >= len(headers):
+					headers = append(headers, c)
+				case c != "":
+					headers[i] = strings.TrimSpace(headers[i] + " " + c)
+				}
+			}
+		}
+		rows = content[headerLines:]
+	}
+	t := New(Options{Format: FormatPlain, Headers: headers, Alignments: plainAlignments(rows)})
+	for _, r := range rows {
+		cells := make([]string, len(r))
+		for i, c := range r {
+			cells[i] = strings.TrimSpace(c)
+		}
+		if err := t.AddRow(cells...); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// tableLines splits s into lines with trailing whitespace removed, dropping
+// blank lines before and after the table.
+func tableLines(s string) []string {
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " \t")
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// splitMarkdownRow splits a Markdown table line into trimmed, unescaped
+// cells.
+func splitMarkdownRow(l string) []string {
+	l = strings.TrimSpace(l)
+	l = strings.TrimPrefix(l, "|")
+	if strings.HasSuffix(l, "|") && !strings.HasSuffix(l, `\|`) {
+		l = l[:len(l)-1]
+	}
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(l); i++ {
+		switch {
+		case l[i] == '\\' && i+1 < len(l) && l[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case l[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(l[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// markdownAlignment returns the alignment a delimiter cell such as ":---:"
+// declares. ok is false if sep is not a delimiter cell.
+func markdownAlignment(sep string) (a Alignment, ok bool) {
+	left, right := strings.HasPrefix(sep, ":"), strings.HasSuffix(sep, ":")
+	dashes := strings.TrimSuffix(strings.TrimPrefix(sep, ":"), ":")
+	if dashes == "" || strings.Trim(dashes, "-") != "" {
+		return AlignLeft, false
+	}
+	switch {
+	case left && right:
+		return AlignCenter, true
+	case right:
+		return AlignRight, true
+	default:
+		return AlignLeft, true
+	}
+}
+
+// plainAlignments infers each column's alignment from the padding of its
+// cells in rows, as split from box-drawn lines: left if every padded value
+// touches the left edge, right if every one touches the right edge,
+// centered if every one is padded evenly, otherwise left. Each cell's single
+// space of border padding is ignored.
+func plainAlignments(rows [][]string) []Alignment {
+	n := 0
+	for _, r := range rows {
+		n = max(n, len(r))
+	}
+	aligns := make([]Alignment, n)
+	for i := range aligns {
+		left, right, center := true, true, true
+		for _, r := range rows {
+			c := cellAt(r, i)
+			c = strings.TrimPrefix(strings.TrimSuffix(c, " "), " ")
+			v := strings.TrimSpace(c)
+			if v == "" || v == c {
+				continue
+			}
+			lead := len(c) - len(strings.TrimLeft(c, " "))
+			trail := len(c) - len(strings.TrimRight(c, " "))
+			left = left && lead == 0
+			right = right && trail == 0
+			center = center && (trail == lead || trail == lead+1)
+		}
+		switch {
+		case left:
+			aligns[i] = AlignLeft
+		case right:
+			aligns[i] = AlignRight
+		case center:
+			aligns[i] = AlignCenter
+		}
+	}
+	return aligns
+}
@@ -0,0 +1,126 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		headers []string
+		aligns  []tablewriter.Alignment
+		rows    [][]string
+	}{
+		{
+			name:    "padded",
+			in:      "\n| Name  | Age | Note     |\n| :---- | --: | :------: |\n| alice |  30 | a \\| b   |\n| bob   |   4 |          |\n",
+			headers: []string{"Name", "Age", "Note"},
+			aligns:  []tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignRight, tablewriter.AlignCenter},
+			rows:    [][]string{{"alice", "30", "a | b"}, {"bob", "4", ""}},
+		},
+		{
+			name:    "loose",
+			in:      "Name | Age\n---|---:\nalice | 30",
+			headers: []string{"Name", "Age"},
+			aligns:  []tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignRight},
+			rows:    [][]string{{"alice", "30"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl, err := tablewriter.ParseMarkdown(tt.in)
+			if err != nil {
+				t.Fatalf("ParseMarkdown() error = %v", err)
+			}
+			opts := tbl.Options()
+			if !reflect.DeepEqual(opts.Headers, tt.headers) || !reflect.DeepEqual(opts.Alignments, tt.aligns) {
+				t.Errorf("headers, alignments = %q, %v, want %q, %v", opts.Headers, opts.Alignments, tt.headers, tt.aligns)
+			}
+			if got := tbl.Rows(); !reflect.DeepEqual(got, tt.rows) {
+				t.Errorf("Rows() = %q, want %q", got, tt.rows)
+			}
+		})
+	}
+}
+
+func TestParseMarkdownErrors(t *testing.T) {
+	for _, in := range []string{"", "| a | b |", "| a | b |\n| x | y |", "| a | b |\n| --- |"} {
+		if _, err := tablewriter.ParseMarkdown(in); !errors.Is(err, tablewriter.ErrParseTable) {
+			t.Errorf("ParseMarkdown(%q) error = %v, want ErrParseTable", in, err)
+		}
+	}
+}
+
+func TestParsePlain(t *testing.T) {
+	in := `
+┌───────┬─────┬────────┐
+│ Name  │ Age │ Status │
+├───────┼─────┼────────┤
+│ alice │  30 │   ok   │
+│ bob   │   4 │  fail  │
+├───────┼─────┼────────┤
+│ Total │  34 │        │
+└───────┴─────┴────────┘
+`
+	tbl, err := tablewriter.ParsePlain(in)
+	if err != nil {
+		t.Fatalf("ParsePlain() error = %v", err)
+	}
+	opts := tbl.Options()
+	if want := []string{"Name", "Age", "Status"}; !reflect.DeepEqual(opts.Headers, want) {
+		t.Errorf("Headers = %q, want %q", opts.Headers, want)
+	}
+	wantAligns := []tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignRight, tablewriter.AlignCenter}
+	if !reflect.DeepEqual(opts.Alignments, wantAligns) {
+		t.Errorf("Alignments = %v, want %v", opts.Alignments, wantAligns)
+	}
+	wantRows := [][]string{{"alice", "30", "ok"}, {"bob", "4", "fail"}, {"Total", "34", ""}}
+	if got := tbl.Rows(); !reflect.DeepEqual(got, wantRows) {
+		t.Errorf("Rows() = %q, want %q", got, wantRows)
+	}
+
+	headerless, err := tablewriter.ParsePlain("┌───┐\n│ a │\n│ b │\n└───┘\n")
+	if err != nil {
+		t.Fatalf("ParsePlain() error = %v", err)
+	}
+	if h, r := headerless.Options().Headers, headerless.Rows(); h != nil || !reflect.DeepEqual(r, [][]string{{"a"}, {"b"}}) {
+		t.Errorf("headerless = %q, %q, want no headers and rows a, b", h, r)
+	}
+	if _, err := tablewriter.ParsePlain("│ a │ b"); !errors.Is(err, tablewriter.ErrParseTable) {
+		t.Errorf("ParsePlain(unterminated) error = %v, want ErrParseTable", err)
+	}
+}
+
+func TestParsedTableReorders(t *testing.T) {
+	parsers := map[string]func() (*tablewriter.Table, error){
+		"markdown": func() (*tablewriter.Table, error) {
+			return tablewriter.ParseMarkdown("| N |\n| --- |\n| 1 |\n| 2 |\n| 3 |\n")
+		},
+		"plain": func() (*tablewriter.Table, error) {
+			return tablewriter.ParsePlain("┌───┐\n│ N │\n├───┤\n│ 1 │\n│ 2 │\n│ 3 │\n└───┘\n")
+		},
+	}
+	for name, parse := range parsers {
+		t.Run(name, func(t *testing.T) {
+			tbl, err := parse()
+			if err != nil {
+				t.Fatalf("parse error = %v", err)
+			}
+			tbl.Reverse()
+			if got, want := tbl.Rows(), [][]string{{"3"}, {"2"}, {"1"}}; !reflect.DeepEqual(got, want) {
+				t.Errorf("Rows() after Reverse = %q, want %q", got, want)
+			}
+			if _, err := tbl.Sample(2, 1); err != nil {
+				t.Errorf("Sample() error = %v", err)
+			}
+			if err := tbl.SetRowMeta(0, tablewriter.RowMeta{"k": "v"}); err != nil {
+				t.Errorf("SetRowMeta() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package tablewriter
+
+import (
+	"context"
+	"os"
+	"strconv"
+)
+
+// terminalWidth returns the terminal width advertised by the COLUMNS
+// environment variable, or 0 if it is unset or invalid.
+func terminalWidth() int {
+	n, err := strconv.Atoi(os.Getenv(EnvColumns))
+	if err != nil || n <= 0 {
+		return 0
+	}
+	return n
+}
+
+// applyColumnPercents resolves opts.ColumnPercents to absolute widths and
+// fits every column with a percentage to exactly that width: longer text is
+// truncated and the header is padded so the column never renders narrower.
+func applyColumnPercents(ctx context.Context, opts Options, rows [][]string) (Options, [][]string, error) {
+	total := opts.MaxTableWidth
+	if total == 0 {
+		total = terminalWidth()
+	}
+	if total == 0 {
+		return opts, rows, nil
+	}
+	widths, err := colWidths(ctx, opts, rows)
+	if err != nil {
+		return opts, nil, err
+	}
+
+	avail := total - tableWidth(opts.Format, make([]int, len(widths)))
+	for i, w := range widths {
+		if percentAt(opts.ColumnPercents, i) == 0 {
+			avail -= w
+		}
+	}
+	target := append([]int(nil), widths...)
+	for i := range target {
+		if p := percentAt(opts.ColumnPercents, i); p > 0 {
+			target[i] = max(1, avail*p/100)
+		}
+	}
+
+	opts = truncateColumns(opts, rows, target)
+	for i := range opts.Headers {
+		if percentAt(opts.ColumnPercents, i) > 0 {
+			h := opts.Headers[i]
+			opts.Headers[i] = alignMeasured(h, measureWidth(h, opts), target[i], alignAt(opts.Alignments, i))
+		}
+	}
+	return opts, rows, nil
+}
+
+// percentAt returns the percentage for column i, or 0 if none is set.
+func percentAt(percents []int, i int) int {
+	if i < len(percents) && percents[i] > 0 {
+		return percents[i]
+	}
+	return 0
+}
+
+// alignAt returns the alignment for column i, defaulting to AlignLeft.
+func alignAt(aligns []Alignment, i int) Alignment {
+	if i < len(aligns) {
+		return aligns[i]
+	}
+	return AlignLeft
+}
@@ -0,0 +1,39 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestColumnPercents(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxWidth int
+		columns  string
+		want     string
+	}{
+		{"max table width", 47, "", "| Name         | Description                  |"},
+		{"terminal width", 0, "47", "| Name         | Description                  |"},
+		{"no width known", 0, "", "| Name  | Description |"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("COLUMNS", tt.columns)
+			opts := tablewriter.Options{
+				Headers:        []string{"Name", "Description"},
+				Format:         tablewriter.FormatMarkdown,
+				MaxTableWidth:  tt.maxWidth,
+				ColumnPercents: []int{30, 70},
+			}
+			out, err := tablewriter.Render(opts, [][]string{{"Alice", "Engineer"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("Render() should contain %q:\n%s", tt.want, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package tablewriter
+
+import (
+	"fmt"
+	"sort"
+)
+
+// fitWidthPercentile truncates or wraps the cells of each column to the
+// width given by columnPercentiles.
+func fitWidthPercentile(opts Options, rows [][]string) [][]string {
+	limits := columnPercentiles(opts, rows)
+	limit := func(col int) int {
+		if col < len(limits) {
+			return limits[col]
+		}
+		return 0
+	}
+	if opts.WrapCells {
+		return opts.cuts.wrap(opts, rows, limit)
+	}
+	for i, r := range rows {
+		for j, c := range r {
+			if r[j] = truncate(c, limit(j), TruncateDisplayWidth); r[j] != c {
+				opts.cuts.cutAt(i, j)
+			}
+		}
+	}
+	return rows
+}
+
+// columnPercentiles returns, per column, the nearest-rank
+// opts.WidthPercentile of its cell widths, raised to the header width.
+func columnPercentiles(opts Options, rows [][]string) []int {
+	n := len(opts.Headers)
+	for _, r := range rows {
+		n = max(n, len(r))
+	}
+	limits := make([]int, n)
+	for col := range limits {
+		widths := make([]int, 0, len(rows))
+		for _, r := range rows {
+			if col < len(r) {
+				widths = append(widths, measureWidth(r[col], opts))
+			}
+		}
+		if len(widths) > 0 {
+			sort.Ints(widths)
+			rank := (opts.WidthPercentile*len(widths) + 99) / 100
+			limits[col] = widths[max(rank-1, 0)]
+		}
+		limits[col] = max(limits[col], measureWidth(cellAt(opts.Headers, col), opts))
+	}
+	return limits
+}
+
+// WithWidthPercentile returns a copy of Options that sizes each text-format
+// column to the p-th percentile of its cell widths; 0 or 100 sizes to the
+// widest cell. Returns ErrInvalidOptions unless 0 <= p <= 100.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithWidthPercentile(95)
+func (o Options) WithWidthPercentile(p int) (Options, error) {
+	if p < 0 || p > 100 {
+		return o, fmt.Errorf("invalid width percentile %d: %w", p, ErrInvalidOptions)
+	}
+	o.WidthPercentile = p
+	return o, nil
+}
@@ -0,0 +1,58 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestWidthPercentile(t *testing.T) {
+	rows := make([][]string, 0, 20)
+	for i := 0; i < 19; i++ {
+		rows = append(rows, []string{"row-" + strconv.Itoa(i%10)})
+	}
+	rows = append(rows, []string{strings.Repeat("x", 500)})
+
+	tests := []struct {
+		name string
+		wrap bool
+	}{
+		{"truncate", false},
+		{"wrap", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:         []string{"Name"},
+				Format:          tablewriter.FormatMarkdown,
+				WidthPercentile: 95,
+				WrapCells:       tt.wrap,
+			}
+			out, err := tablewriter.Render(opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if strings.Contains(out, strings.Repeat("x", 6)) {
+				t.Errorf("Render() kept the pathological cell wider than the 95th percentile:\n%.200s", out)
+			}
+			if !strings.Contains(out, "| row-9 ") {
+				t.Errorf("Render() truncated typical cells:\n%.200s", out)
+			}
+		})
+	}
+}
+
+func TestWithWidthPercentile(t *testing.T) {
+	for _, p := range []int{-1, 101} {
+		if _, err := tablewriter.DefaultOptions().WithWidthPercentile(p); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+			t.Errorf("WithWidthPercentile(%d) error = %v, want %v", p, err, tablewriter.ErrInvalidOptions)
+		}
+	}
+	opts, err := tablewriter.DefaultOptions().WithWidthPercentile(90)
+	if err != nil || opts.WidthPercentile != 90 {
+		t.Errorf("WithWidthPercentile(90) = %d, %v", opts.WidthPercentile, err)
+	}
+}
@@ -0,0 +1,390 @@
+package tablewriter
+
+// prepare applies the render-time transformations described by opts to a
+// copy of rows before they reach a format renderer. meta holds per-row
+// metadata aligned with rows and may be nil. The caller's rows are never
+// modified.
+func prepare(opts Options, rows [][]string, meta []RowMeta) (Options, [][]string, error) {
+	opts, rows, err := prepareRows(opts, rows, meta)
+	if err != nil {
+		return opts, nil, err
+	}
+	return prepareFormat(opts, rows)
+}
+
+// prepareRows applies the transformations that do not depend on
+// opts.Format to a copy of rows: filtering, sorting, paging, formatting,
+// column selection, and schema validation.
+func prepareRows(opts Options, rows [][]string, meta []RowMeta) (Options, [][]string, error) {
+	rows = cloneRows(rows)
+	opts = resolveColumns(opts)
+	if opts.StrictColumnCount {
+		if err := checkColumnCount(opts, rows); err != nil {
+			return opts, nil, err
+		}
+	}
+	if hasColumnTypes(opts) {
+		opts.Alignments = typedAlignments(opts)
+	}
+	if opts.SanitizeUTF8 {
+		fix := func(v string) string { return sanitizeUTF8(v, opts.InvalidUTF8Marker) }
+		opts.Headers = mapCells(opts.Headers, fix)
+		for i, r := range rows {
+			rows[i] = mapCells(r, fix)
+		}
+	}
+	for _, f := range opts.RowTransforms {
+		for i, r := range rows {
+			rows[i] = f(r)
+		}
+	}
+	for _, f := range opts.MetaTransforms {
+		for i, r := range rows {
+			rows[i] = f(r, metaAt(meta, i))
+		}
+	}
+	if opts.RaggedRows != RaggedKeep {
+		if err := fixRaggedRows(opts, rows); err != nil {
+			return opts, nil, err
+		}
+	}
+	src := sourceIndexes(len(rows))
+	if opts.RowFilter != nil {
+		rows, src = filterRows(rows, src, func(r []string, _ int) bool { return opts.RowFilter(r) })
+	}
+	if opts.MetaFilter != nil {
+		rows, src = filterRows(rows, src, func(r []string, j int) bool { return opts.MetaFilter(r, metaAt(meta, j)) })
+	}
+	if opts.SortFunc != nil {
+		sortRowsFunc(rows, src, opts.SortFunc)
+	} else if keys := sortKeys(opts); len(keys) > 0 {
+		if err := sortRows(opts, rows, src, keys); err != nil {
+			return opts, nil, err
+		}
+	}
+	if opts.Offset != 0 || opts.Limit != 0 {
+		rows, src = pageRows(opts, rows, src)
+	}
+	if len(opts.Footnotes) > 0 {
+		opts.Footnotes = resolveFootnotes(opts.Footnotes, src)
+	}
+	var styles [][]cellStyle
+	if len(opts.StyleRules) > 0 {
+		var err error
+		if styles, err = matchStyleRules(opts, rows); err != nil {
+			return opts, nil, err
+		}
+	}
+	if len(opts.Formatters) > 0 {
+		applyFormatters(opts.Formatters, rows)
+	}
+	if styles != nil && opts.Format == FormatHTML {
+		opts.cellClasses = styleClasses(styles)
+	} else if styles != nil && keepsColor(opts.Format) && !opts.NoColor {
+		applyStyles(rows, styles)
+	}
+	if opts.NoColor {
+		opts.Headers = mapCells(opts.Headers, stripANSI)
+		for i, r := range rows {
+			rows[i] = mapCells(r, stripANSI)
+		}
+	}
+	if opts.OmitEmptyColumns {
+		opts, rows = dropEmptyColumns(opts, rows)
+	}
+	if len(opts.Columns) > 0 {
+		opts, rows = dropHiddenColumns(opts, rows)
+	}
+	if len(opts.WideColumns) > 0 && !opts.Wide {
+		opts, rows = dropWideColumns(opts, rows)
+	}
+	if len(opts.ColumnFilter) > 0 {
+		var err error
+		if opts, rows, err = filterColumns(opts, rows); err != nil {
+			return opts, nil, err
+		}
+	}
+	if opts.Schema != nil {
+		if err := validateRows(opts, rows); err != nil {
+			return opts, nil, err
+		}
+	}
+	if opts.MetaRowClass != nil && opts.Format == FormatHTML {
+		opts.rowClasses = make([]string, len(rows))
+		for i, r := range rows {
+			opts.rowClasses[i] = opts.MetaRowClass(r, metaAt(meta, src[i]))
+		}
+	}
+	return opts, rows, nil
+}
+
+// prepareFormat applies the transformations specific to opts.Format to
+// rows from prepareRows, in place: null placeholders, per-column widths,
+// text layout, CSV and Markdown escaping, and header labels.
+func prepareFormat(opts Options, rows [][]string) (Options, [][]string, error) {
+	opts = resolveNulls(opts, rows)
+	if len(opts.NullPlaceholders) > 0 {
+		fillNullPlaceholders(opts.NullPlaceholders, rows)
+	}
+	if len(opts.MaxColumnWidths) > 0 {
+		truncatePerColumn(opts, rows)
+	}
+	if len(opts.CollapseRepeats) > 0 && isTextFormat(opts.Format) {
+		collapseRepeats(opts, rows)
+	}
+	if opts.MinRows > len(rows) && isTextFormat(opts.Format) && !(len(rows) == 0 && opts.EmptyMessage != "") {
+		rows = padRows(opts, rows)
+	}
+	if opts.EscapeFormulas && opts.Format == FormatCSV {
+		opts.Headers = escapeFormulas(opts, opts.Headers)
+		for i, r := range rows {
+			rows[i] = escapeFormulas(opts, r)
+		}
+	}
+	if opts.Format == FormatJSON {
+		hs, err := dedupeHeaders(opts.Headers, opts.DuplicateHeaders)
+		if err != nil {
+			return opts, nil, err
+		}
+		opts.Headers = hs
+	} else {
+		opts.Headers = mapCells(opts.Headers, func(h string) string {
+			return applyHeaderOpts(headerLabel(h, opts), opts)
+		})
+	}
+	if opts.Format == FormatMarkdown {
+		opts = escapeMarkdown(opts, rows)
+	}
+	if opts.NoHeader && omitsHeader(opts.Format) {
+		opts.Headers = nil
+	}
+	return opts, rows, nil
+}
+
+// sourceIndexes returns 0..n-1, the source index of each of n rows before
+// filtering, sorting, and paging reorder them.
+func sourceIndexes(n int) []int {
+	src := make([]int, n)
+	for i := range src {
+		src[i] = i
+	}
+	return src
+}
+
+// filterRows returns the rows for which keep returns true, and their
+// source indexes from src. keep receives each row and its source index.
+func filterRows(rows [][]string, src []int, keep func(row []string, src int) bool) ([][]string, []int) {
+	out, outSrc := rows[:0], src[:0]
+	for i, r := range rows {
+		if keep(r, src[i]) {
+			out = append(out, r)
+			outSrc = append(outSrc, src[i])
+		}
+	}
+	return out, outSrc
+}
+
+// omitsHeader reports whether NoHeader applies to format f.
+func omitsHeader(f Format) bool {
+	switch f {
+	case FormatPlain, FormatSimple, FormatCSV, FormatHTML:
+		return true
+	default:
+		return false
+	}
+}
+
+// cloneRows returns a deep copy of rows.
+func cloneRows(rows [][]string) [][]string {
+	out := make([][]string, len(rows))
+	for i, r := range rows {
+		out[i] = make([]string, len(r))
+		copy(out[i], r)
+	}
+	return out
+}
+
+// mapCells returns a new slice with f applied to every cell.
+func mapCells(cells []string, f func(string) string) []string {
+	if cells == nil {
+		return nil
+	}
+	out := make([]string, len(cells))
+	for i, c := range cells {
+		out[i] = f(c)
+	}
+	return out
+}
+
+// fillNullPlaceholders replaces empty cells in rows with the placeholder
+// configured for their column, if any.
+func fillNullPlaceholders(placeholders []string, rows [][]string) {
+	for _, r := range rows {
+		for i, c := range r {
+			if c == "" && i < len(placeholders) {
+				r[i] = placeholders[i]
+			}
+		}
+	}
+}
+
+// padRows appends blank rows until there are opts.MinRows. Blank cells are a
+// single space when a NullPlaceholder is set, so the placeholder does not
+// fill them in.
+func padRows(opts Options, rows [][]string) [][]string {
+	blank := ""
+	if opts.NullPlaceholder != "" {
+		blank = " "
+	}
+	n := len(opts.Headers)
+	for _, r := range rows {
+		n = max(n, len(r))
+	}
+	for len(rows) < opts.MinRows {
+		row := make([]string, n)
+		for i := range row {
+			row[i] = blank
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// collapseRepeats blanks cells in collapsing columns that repeat the value
+// above them, as long as every collapsing column to the left also repeats.
+// Blanked cells become a single space when a NullPlaceholder is set, so the
+// placeholder does not fill them back in.
+func collapseRepeats(opts Options, rows [][]string) {
+	blank := ""
+	if opts.NullPlaceholder != "" {
+		blank = " "
+	}
+	prev := cloneRows(rows)
+	for r := 1; r < len(rows); r++ {
+		for i := range rows[r] {
+			if i >= len(opts.CollapseRepeats) || !opts.CollapseRepeats[i] {
+				continue
+			}
+			if i >= len(prev[r-1]) || prev[r][i] != prev[r-1][i] {
+				break
+			}
+			rows[r][i] = blank
+		}
+	}
+}
+
+// truncatePerColumn truncates cells in columns that have their own width in
+// opts.MaxColumnWidths.
+func truncatePerColumn(opts Options, rows [][]string) {
+	for _, r := range rows {
+		for i, c := range r {
+			if i < len(opts.MaxColumnWidths) && opts.MaxColumnWidths[i] > 0 {
+				r[i] = truncate(c, opts.MaxColumnWidths[i], opts.TruncateUnit)
+			}
+		}
+	}
+}
+
+// selectColumns keeps only the columns for which keep returns true, removing
+// the matching entries from every per-column option, from every footer, and
+// from every row.
+func selectColumns(opts Options, rows [][]string, keep func(col int) bool) (Options, [][]string) {
+	opts.Columns = pickColumns(opts.Columns, keep)
+	opts.Headers = pickColumns(opts.Headers, keep)
+	opts.Alignments = pickColumns(opts.Alignments, keep)
+	opts.MaxColumnWidths = pickColumns(opts.MaxColumnWidths, keep)
+	opts.MinColumnWidths = pickColumns(opts.MinColumnWidths, keep)
+	opts.ColumnPercents = pickColumns(opts.ColumnPercents, keep)
+	opts.ColumnPriorities = pickColumns(opts.ColumnPriorities, keep)
+	opts.Formatters = pickColumns(opts.Formatters, keep)
+	opts.NullPlaceholders = pickColumns(opts.NullPlaceholders, keep)
+	opts.CollapseRepeats = pickColumns(opts.CollapseRepeats, keep)
+	opts.ColumnTypes = pickColumns(opts.ColumnTypes, keep)
+	opts.Units = pickColumns(opts.Units, keep)
+	opts.SplitAnchors = pickColumns(opts.SplitAnchors, keep)
+	if opts.Footers != nil {
+		footers := make([][]string, len(opts.Footers))
+		for r, row := range opts.Footers {
+			footers[r] = pickColumns(row, keep)
+		}
+		opts.Footers = footers
+	}
+	if opts.Footnotes != nil {
+		opts.Footnotes = pickFootnotes(opts.Footnotes, keep)
+	}
+	if opts.cellClasses != nil {
+		classes := make([][]string, len(opts.cellClasses))
+		for r, row := range opts.cellClasses {
+			classes[r] = pickColumns(row, keep)
+		}
+		opts.cellClasses = classes
+	}
+	for r, row := range rows {
+		rows[r] = pickColumns(row, keep)
+	}
+	return opts, rows
+}
+
+// pickColumns returns the elements of s whose index satisfies keep. A nil
+// slice stays nil.
+func pickColumns[T any](s []T, keep func(col int) bool) []T {
+	if s == nil {
+		return nil
+	}
+	out := make([]T, 0, len(s))
+	for i, v := range s {
+		if keep(i) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// pickFootnotes returns the notes on columns that satisfy keep, with each
+// Column renumbered to the column's position among those kept.
+func pickFootnotes(fns []Footnote, keep func(col int) bool) []Footnote {
+	out := make([]Footnote, 0, len(fns))
+	for _, fn := range fns {
+		if fn.Column < 0 || !keep(fn.Column) {
+			continue
+		}
+		col := 0
+		for i := 0; i < fn.Column; i++ {
+			if keep(i) {
+				col++
+			}
+		}
+		fn.Column = col
+		out = append(out, fn)
+	}
+	return out
+}
+
+// dropEmptyColumns removes columns in which every data cell is empty or the
+// NullPlaceholder, or Null. Tables with no rows keep all their columns.
+func dropEmptyColumns(opts Options, rows [][]string) (Options, [][]string) {
+	if len(rows) == 0 {
+		return opts, rows
+	}
+	used := map[int]bool{}
+	for _, r := range rows {
+		for i, c := range r {
+			if c != "" && c != Null && c != opts.NullPlaceholder {
+				used[i] = true
+			}
+		}
+	}
+	return selectColumns(opts, rows, func(col int) bool { return used[col] })
+}
+
+// dropWideColumns removes the columns named in opts.WideColumns.
+func dropWideColumns(opts Options, rows [][]string) (Options, [][]string) {
+	wide := make(map[string]bool, len(opts.WideColumns))
+	for _, h := range opts.WideColumns {
+		wide[h] = true
+	}
+	headers := opts.Headers
+	return selectColumns(opts, rows, func(col int) bool {
+		return col >= len(headers) || !wide[headers[col]]
+	})
+}
@@ -0,0 +1,96 @@
+package tablewriter
+
+import "fmt"
+
+// RaggedRows selects what rendering does with rows whose cell count differs
+// from the column count. Policies other than RaggedKeep are flags and may
+// be combined, e.g. PadShortRows|TruncateLongRows.
+type RaggedRows int
+
+const (
+	RaggedKeep       RaggedRows = 0               // RaggedKeep leaves rows as given; each renderer copes (default).
+	PadShortRows     RaggedRows = 1 << (iota - 1) // PadShortRows appends empty cells to short rows.
+	TruncateLongRows                              // TruncateLongRows drops cells beyond the last column.
+	RaggedError                                   // RaggedError fails the render with ErrColumnMismatch.
+)
+
+// checkColumnCount returns ErrColumnMismatch if a row's cell count differs
+// from the number of headers, enforcing StrictColumnCount for rows that did
+// not pass through AddRow, such as those given to the package-level Render.
+func checkColumnCount(opts Options, rows [][]string) error {
+	n := len(opts.Headers)
+	if n == 0 {
+		return nil
+	}
+	for i, r := range rows {
+		if len(r) != n {
+			return fmt.Errorf("%w: row %d has %d cells, want %d", ErrColumnMismatch, i, len(r), n)
+		}
+	}
+	return nil
+}
+
+// fixRaggedRows applies opts.RaggedRows to rows in place. The column count
+// is the number of headers, or the longest row's length without headers.
+func fixRaggedRows(opts Options, rows [][]string) error {
+	n := len(opts.Headers)
+	if n == 0 {
+		for _, r := range rows {
+			n = max(n, len(r))
+		}
+	}
+	for i, r := range rows {
+		if len(r) == n {
+			continue
+		}
+		switch {
+		case opts.RaggedRows&RaggedError != 0:
+			return fmt.Errorf("%w: row %d has %d cells, want %d", ErrColumnMismatch, i, len(r), n)
+		case len(r) < n && opts.RaggedRows&PadShortRows != 0:
+			rows[i] = append(r, make([]string, n-len(r))...)
+		case len(r) > n && opts.RaggedRows&TruncateLongRows != 0:
+			rows[i] = r[:n]
+		}
+	}
+	return nil
+}
+
+// raggedRowsNames maps each RaggedRows to its name in configuration files.
+var raggedRowsNames = map[RaggedRows]string{
+	RaggedKeep:       "keep",
+	PadShortRows:     "pad",
+	TruncateLongRows: "truncate",
+	RaggedError:      "error",
+}
+
+// MarshalText encodes r as the names of its flags joined by "|", e.g.
+// "pad|truncate", implementing encoding.TextMarshaler.
+func (r RaggedRows) MarshalText() ([]byte, error) {
+	return marshalFlags(r, raggedRowsNames, "RaggedRows")
+}
+
+// UnmarshalText parses the names written by MarshalText, ignoring case,
+// implementing encoding.TextUnmarshaler.
+func (r *RaggedRows) UnmarshalText(text []byte) error {
+	v, err := unmarshalFlags(text, raggedRowsNames, "RaggedRows")
+	if err != nil {
+		return err
+	}
+	*r = v
+	return nil
+}
+
+// WithRaggedRows returns a copy of Options that corrects rows with the
+// wrong cell count as r selects. Returns ErrInvalidOptions if r has unknown
+// flags.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithRaggedRows(tablewriter.PadShortRows | tablewriter.TruncateLongRows)
+func (o Options) WithRaggedRows(r RaggedRows) (Options, error) {
+	if r&^(PadShortRows|TruncateLongRows|RaggedError) != 0 {
+		return o, fmt.Errorf("invalid ragged rows %d: %w", int(r), ErrInvalidOptions)
+	}
+	o.RaggedRows = r
+	return o, nil
+}
@@ -0,0 +1,61 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRaggedRows(t *testing.T) {
+	rows := [][]string{{"a"}, {"b", "2"}, {"c", "3", "extra"}}
+	tests := []struct {
+		name    string
+		policy  tablewriter.RaggedRows
+		want    string
+		wantErr error
+	}{
+		{"keep", tablewriter.RaggedKeep, "A,B\na\nb,2\nc,3,extra\n", nil},
+		{"pad", tablewriter.PadShortRows, "A,B\na,\nb,2\nc,3,extra\n", nil},
+		{"truncate", tablewriter.TruncateLongRows, "A,B\na\nb,2\nc,3\n", nil},
+		{"pad and truncate", tablewriter.PadShortRows | tablewriter.TruncateLongRows, "A,B\na,\nb,2\nc,3\n", nil},
+		{"error", tablewriter.RaggedError, "", tablewriter.ErrColumnMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Headers: []string{"A", "B"}, Format: tablewriter.FormatCSV, RaggedRows: tt.policy}
+			got, err := tablewriter.Render(opts, rows)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Render() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderStrictColumnCount(t *testing.T) {
+	opts := tablewriter.Options{Headers: []string{"A", "B"}, Format: tablewriter.FormatCSV, StrictColumnCount: true}
+	if _, err := tablewriter.Render(opts, [][]string{{"1", "2"}, {"3"}}); !errors.Is(err, tablewriter.ErrColumnMismatch) {
+		t.Errorf("Render() error = %v, want %v", err, tablewriter.ErrColumnMismatch)
+	}
+	if _, err := tablewriter.Render(opts, [][]string{{"1", "2"}}); err != nil {
+		t.Errorf("Render() error = %v, want nil", err)
+	}
+	opts.RaggedRows = tablewriter.PadShortRows
+	if _, err := tablewriter.Render(opts, [][]string{{"3"}}); !errors.Is(err, tablewriter.ErrColumnMismatch) {
+		t.Errorf("Render() with PadShortRows error = %v, want strict mode to win", err)
+	}
+}
+
+func TestWithRaggedRows(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithRaggedRows(tablewriter.RaggedRows(16)); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithRaggedRows(16) error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+	want := tablewriter.PadShortRows | tablewriter.TruncateLongRows
+	opts, err := tablewriter.DefaultOptions().WithRaggedRows(want)
+	if err != nil || opts.RaggedRows != want {
+		t.Errorf("WithRaggedRows(%v) = %v, %v", want, opts.RaggedRows, err)
+	}
+}
@@ -0,0 +1,61 @@
+package tablewriter
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RelativeTimeFormatter returns a Formatter that renders timestamps relative
+// to now(), such as "3h ago", "in 2d", or "now". Cells are parsed with
+// layout, or time.RFC3339 if layout is empty; cells that do not parse are
+// left unchanged. Pass time.Now for now, or a fixed clock in tests.
+//
+// Example:
+//
+//	opts := tablewriter.Options{
+//	    Headers:    []string{"Event", "When"},
+//	    Formatters: []tablewriter.Formatter{nil, tablewriter.RelativeTimeFormatter(time.Now, "")},
+//	}
+func RelativeTimeFormatter(now func() time.Time, layout string) Formatter {
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	return func(v string) string {
+		ts, err := time.Parse(layout, strings.TrimSpace(v))
+		if err != nil {
+			return v
+		}
+		return relativeTime(now().Sub(ts))
+	}
+}
+
+// relativeUnits are the units used by relativeTime, largest first.
+var relativeUnits = []struct {
+	d      time.Duration
+	suffix string
+}{
+	{365 * 24 * time.Hour, "y"},
+	{24 * time.Hour, "d"},
+	{time.Hour, "h"},
+	{time.Minute, "m"},
+	{time.Second, "s"},
+}
+
+// relativeTime formats d, positive for the past, in the largest whole unit.
+func relativeTime(d time.Duration) string {
+	future := d < 0
+	if future {
+		d = -d
+	}
+	for _, u := range relativeUnits {
+		if d >= u.d {
+			s := strconv.FormatInt(int64(d/u.d), 10) + u.suffix
+			if future {
+				return "in " + s
+			}
+			return s + " ago"
+		}
+	}
+	return "now"
+}
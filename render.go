@@ -3,9 +3,7 @@ package tablewriter
 // Package tablewriter provides a simple way to render tables in various formats.
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -15,33 +13,140 @@ import (
 // ErrInvalidFormat is returned when an invalid format is provided.
 var ErrInvalidFormat = errors.New("invalid format")
 
-// ErrInvalidOptions is returned when invalid options are provided.
-var ErrInvalidOptions = errors.New("invalid options")
-
 // render renders a table based on the provided options and rows.
+func render(opts Options, rows [][]string) (string, error) {
+	return renderContext(context.Background(), opts, rows)
+}
+
+// renderContext renders a table based on the provided options and rows.
 //
-// render takes a context, options and rows as input, and returns the rendered table as a string, and an error if any.
-func render(ctx context.Context, opts Options, rows [][]string) (string, error) {
+// renderContext takes a context, options and rows as input, and returns the rendered table as a string, and an error if any.
+func renderContext(ctx context.Context, opts Options, rows [][]string) (string, error) {
 	if ctx == nil {
 		return "", errors.New("context is nil")
 	}
-	if opts == nil {
-		return "", ErrInvalidOptions
-	}
 	if rows == nil {
 		return "", errors.New("rows is nil")
 	}
+	var out string
+	var err error
+	if len(opts.Footnotes) > 0 && opts.Format == FormatHTML {
+		return "", fmt.Errorf("%w: footnotes are not supported in %s", ErrInvalidOptions, opts.Format)
+	}
+	if len(opts.Footnotes) > 0 && isTextFormat(opts.Format) {
+		out, err = renderFootnotes(ctx, opts, rows)
+	} else {
+		out, err = renderTable(ctx, opts, rows)
+	}
+	if err != nil {
+		return "", err
+	}
+	for _, f := range opts.PostRender {
+		out = f(out)
+	}
+	out = opts.Charset.encode(out, opts.CharsetReplacement)
+	if opts.MaxOutputBytes > 0 {
+		out = limitOutput(out, opts.MaxOutputBytes)
+	}
+	return out, nil
+}
+
+// renderTable renders rows beneath any units row and followed by any
+// footers.
+func renderTable(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	if hasUnits(opts) && len(opts.Headers) > 0 && isTextFormat(opts.Format) && !opts.WrapHeaders {
+		return renderUnits(ctx, opts, rows)
+	}
+	if len(opts.Footers) > 0 && isTextFormat(opts.Format) {
+		return renderFooters(ctx, opts, rows)
+	}
+	return renderLayout(ctx, opts, rows)
+}
+
+// renderLayout chooses how rows are laid out: empty, responsive, split, or
+// as a single table, rendered in parallel when it is large enough.
+func renderLayout(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	if len(rows) == 0 {
+		return renderEmpty(ctx, opts)
+	}
+	opts, rows, err := sizeColumns(ctx, opts, rows)
+	if err != nil {
+		return "", err
+	}
+	if opts.WrapHeaders && isTextFormat(opts.Format) {
+		return renderWrappedHeaders(ctx, opts, rows)
+	}
+	if opts.ResponsiveWidth > 0 && isTextFormat(opts.Format) {
+		return renderResponsive(ctx, opts, rows)
+	}
+	if opts.SplitWidth > 0 && isTextFormat(opts.Format) {
+		return renderSplit(ctx, opts, rows)
+	}
+	if canRenderParallel(opts, rows) {
+		return renderParallel(ctx, opts, rows)
+	}
+	return renderFormat(ctx, opts, rows)
+}
+
+// sizeColumns applies the width options that reshape cells before layout:
+// WidthPercentile, WrapCells, ColumnPercents, and MaxTableWidth.
+func sizeColumns(ctx context.Context, opts Options, rows [][]string) (Options, [][]string, error) {
+	if !isTextFormat(opts.Format) {
+		opts.cuts.start(len(rows))
+		opts.cuts.cells(opts, rows)
+		return opts, rows, nil
+	}
+	opts.cuts.start(len(rows) - len(opts.Footers))
+	if opts.WidthPercentile > 0 && opts.WidthPercentile < 100 {
+		rows = fitWidthPercentile(opts, rows)
+	}
+	if opts.WrapCells && opts.MaxColumnWidth > 0 {
+		rows = opts.cuts.wrap(opts, rows, func(int) int { return opts.MaxColumnWidth })
+		opts.MaxColumnWidth = 0
+	}
+	var err error
+	if len(opts.ColumnPercents) > 0 {
+		if opts, rows, err = applyColumnPercents(ctx, opts, rows); err != nil {
+			return opts, nil, err
+		}
+	}
+	if opts.MaxTableWidth > 0 {
+		if opts, rows, err = fitTableWidth(ctx, opts, rows); err != nil {
+			return opts, nil, err
+		}
+	}
+	opts.cuts.cells(opts, rows)
+	return opts, rows, nil
+}
+
+// renderFormat dispatches rows to the renderer for opts.Format.
+func renderFormat(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	if opts.Format == FormatJSON && (opts.NestedJSON || hasColumnTypes(opts)) {
+		return renderObjectJSON(ctx, opts, rows)
+	}
 	switch opts.Format {
+	case FormatPlain:
+		return renderPlain(ctx, opts, rows)
 	case FormatMarkdown:
+		if customMarkdown(opts) {
+			return renderMarkdownCustom(ctx, opts, rows)
+		}
 		return renderMarkdown(ctx, opts, rows)
 	case FormatCSV:
+		if customCSV(opts) {
+			return renderCSVDialect(ctx, opts, rows)
+		}
 		return renderCSV(ctx, opts, rows)
 	case FormatJSON:
 		return renderJSON(ctx, opts, rows)
 	case FormatSimple:
 		return renderSimple(ctx, opts, rows)
+	case FormatList:
+		return renderList(ctx, opts, rows)
+	case FormatHTML:
+		return renderHTML(ctx, opts, rows)
 	default:
-		return "", fmt.Errorf("invalid format: %s", opts.Format)
+		return "", fmt.Errorf("%w: %v", ErrInvalidFormat, opts.Format)
 	}
 }
 
@@ -52,12 +157,20 @@ func colWidths(ctx context.Context, opts Options, rows [][]string) ([]int, error
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
-	if opts == nil {
-		return nil, ErrInvalidOptions
-	}
 	if rows == nil {
 		return nil, errors.New("rows is nil")
 	}
+	if opts.widthScope != nil {
+		return opts.widthScope.widths(func() []int { return measureColumns(opts, rows) }), nil
+	}
+	return measureColumns(opts, rows), nil
+}
+
+// measureColumns returns the display width of each column across headers
+// and rows, after cell options are applied. Columns are sized from the
+// truncated cells rather than clamped to MaxColumnWidth, since TruncateUnit
+// may count runes or bytes rather than display columns.
+func measureColumns(opts Options, rows [][]string) []int {
 	numCols := len(opts.Headers)
 	for _, r := range rows {
 		if len(r) > numCols {
@@ -65,29 +178,41 @@ func colWidths(ctx context.Context, opts Options, rows [][]string) ([]int, error
 		}
 	}
 	widths := make([]int, numCols)
-	for i, h := range opts.Headers {
-		w := utf8.RuneCountInString(h)
-		if w > widths[i] {
-			widths[i] = w
-		}
-	}
 	for _, r := range rows {
 		for i, c := range r {
-			c = applyCellOpts(c, opts)
-			w := utf8.RuneCountInString(c)
+			c, _ = applyCellOpts(c, opts)
+			w := measureWidth(c, opts)
 			if w > widths[i] {
 				widths[i] = w
 			}
 		}
 	}
-	if opts.MaxColumnWidth > 0 {
+	for i, h := range opts.Headers {
+		w := measureWidth(applyHeaderOpts(h, opts), opts)
+		if w > widths[i] {
+			widths[i] = w
+		}
+	}
+	if opts.Format == FormatMarkdown && !opts.MarkdownLoose && len(opts.Headers) > 0 {
 		for i := range widths {
-			if widths[i] > opts.MaxColumnWidth {
-				widths[i] = opts.MaxColumnWidth
-			}
+			widths[i] = max(widths[i], len(markdownSeparator(alignAt(opts.Alignments, i), 0)))
 		}
 	}
-	return widths, nil
+	return widths
+}
+
+// applyHeaderOpts truncates a header to its width limit: HeaderMaxWidth if
+// set, otherwise MaxColumnWidth unless ExemptHeadersFromTruncation is true.
+func applyHeaderOpts(h string, opts Options) string {
+	return truncate(h, headerLimit(opts), opts.TruncateUnit)
+}
+
+// headerLimit returns the width limit applyHeaderOpts truncates headers to.
+func headerLimit(opts Options) int {
+	if opts.HeaderMaxWidth == 0 && !opts.ExemptHeadersFromTruncation {
+		return opts.MaxColumnWidth
+	}
+	return opts.HeaderMaxWidth
 }
 
 // applyCellOpts applies the cell options to the given value.
@@ -97,51 +222,109 @@ func applyCellOpts(v string, opts Options) (string, error) {
 	if v == "" && opts.NullPlaceholder != "" {
 		v = opts.NullPlaceholder
 	}
-	if opts.MaxColumnWidth > 0 && utf8.RuneCountInString(v) > opts.MaxColumnWidth {
-		runes := []rune(v)
-		if opts.MaxColumnWidth > 3 {
-			v = string(runes[:opts.MaxColumnWidth-3]) + "..."
-		} else {
-			v = string(runes[:opts.MaxColumnWidth])
+	return truncate(v, opts.MaxColumnWidth, opts.TruncateUnit), nil
+}
+
+// truncate shortens v to at most limit units, measured according to unit,
+// ending in "..." when there is room for it. A limit of 0 means no limit.
+// Multibyte characters are never split. Unless unit is TruncateBytes, ANSI
+// escape sequences take no space and are never cut; if any precede the cut,
+// a reset sequence follows the "..." so styling does not leak.
+func truncate(v string, limit int, unit TruncateUnit) string {
+	if limit <= 0 {
+		return v
+	}
+	size := unit.measure()
+	skipANSI := unit != TruncateBytes
+	total := 0
+	for i := 0; i < len(v); {
+		if end := ansiSeqEnd(v, i); skipANSI && end > i {
+			i = end
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(v[i:])
+		total += size(r)
+		i += n
+	}
+	if total <= limit {
+		return v
+	}
+
+	budget, suffix := limit, ""
+	if limit > 3 {
+		budget, suffix = limit-3, "..."
+	}
+	used, styled := 0, false
+	for i := 0; i < len(v); {
+		if end := ansiSeqEnd(v, i); skipANSI && end > i {
+			styled = true
+			i = end
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(v[i:])
+		if used+size(r) > budget {
+			if styled {
+				suffix += ansiReset
+			}
+			return v[:i] + suffix
 		}
+		used += size(r)
+		i += n
 	}
-	return v, nil
+	return v + suffix
 }
 
 // alignCell aligns the cell to the given width and alignment.
 //
 // alignCell takes a string, width, and alignment as input, and returns the aligned string, and an error if any.
 func alignCell(s string, width int, align Alignment) (string, error) {
-	slen := utf8.RuneCountInString(s)
+	return alignMeasured(s, displayWidth(s), width, align), nil
+}
+
+// alignMeasured pads s, whose display width is slen, to width according to
+// align. Renderers with Options use it with measureWidth so that a custom
+// WidthFunc is honored.
+func alignMeasured(s string, slen, width int, align Alignment) string {
 	pad := width - slen
 	if pad <= 0 {
-		return s, nil
+		return s
 	}
 	switch align {
 	case AlignRight:
-		return strings.Repeat(" ", pad) + s, nil
+		return padding(pad) + s
 	case AlignCenter:
 		left := pad / 2
 		right := pad - left
-		return strings.Repeat(" ", left) + s + strings.Repeat(" ", right), nil
+		return padding(left) + s + padding(right)
 	default:
-		return s + strings.Repeat(" ", pad), nil
+		return s + padding(pad)
 	}
 }
 
+// spaces backs padding; cells are rarely padded by more than this.
+var spaces = strings.Repeat(" ", 256)
+
+// padding returns n spaces, sliced from a shared string when possible so
+// padding a cell allocates only the padded result.
+func padding(n int) string {
+	if n <= len(spaces) {
+		return spaces[:n]
+	}
+	return strings.Repeat(" ", n)
+}
+
 // getAlign gets the alignment for the given column.
 //
 // getAlign takes a context, options, and column as input, and returns the alignment for the given column, and an error if any.
 func getAlign(ctx context.Context, opts Options, col int) (Alignment, error) {
 	if ctx == nil {
-		return "", errors.New("context is nil")
-	}
-	if opts == nil {
-		return "", ErrInvalidOptions
+		return AlignLeft, errors.New("context is nil")
 	}
 	if col < 0 || col >= len(opts.Headers) {
-		return "", fmt.Errorf("column out of range: %d", col)
+		return AlignLeft, fmt.Errorf("column out of range: %d", col)
+	}
+	if col < len(opts.Alignments) {
+		return opts.Alignments[col], nil
 	}
-	// alignment logic
-	return "", nil
+	return AlignLeft, nil
 }
@@ -0,0 +1,37 @@
+package tablewriter
+
+import "fmt"
+
+// RenderAll renders the table in each of formats, sharing one pass of the
+// format-independent preparation (filtering, sorting, paging, formatters,
+// column selection) across all of them, for publishing the same table as,
+// say, Markdown, CSV, and JSON at once.
+// Returns the first rendering error, prefixed with the format's name.
+//
+// Example:
+//
+//	outs, err := t.RenderAll(tablewriter.FormatMarkdown, tablewriter.FormatCSV, tablewriter.FormatJSON)
+//	if err != nil {
+//	    return err
+//	}
+//	os.WriteFile("report.csv", []byte(outs[tablewriter.FormatCSV]), 0o644)
+func (t *Table) RenderAll(formats ...Format) (map[Format]string, error) {
+	opts, rows, err := prepareRows(t.opts, t.rows, t.meta)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[Format]string, len(formats))
+	for _, f := range formats {
+		o := opts
+		o.Format = f
+		o = t.withWidthCache(o, widthUseRender)
+		o, r, err := prepareFormat(o, cloneRows(rows))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f, err)
+		}
+		if out[f], err = render(o, r); err != nil {
+			return nil, fmt.Errorf("%s: %w", f, err)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,44 @@
+package tablewriter_test
+
+import (
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRenderAll(t *testing.T) {
+	calls := 0
+	opts := tablewriter.Options{
+		Headers:         []string{"Name", "Email"},
+		SortBy:          "Name",
+		NullPlaceholder: "-",
+		Formatters: []tablewriter.Formatter{func(s string) string {
+			calls++
+			return s
+		}},
+	}
+	tbl := tablewriter.New(opts)
+	tbl.AddRow("bob", "")
+	tbl.AddRow("alice", "a@example.com")
+
+	formats := []tablewriter.Format{tablewriter.FormatMarkdown, tablewriter.FormatCSV, tablewriter.FormatList}
+	outs, err := tbl.RenderAll(formats...)
+	if err != nil {
+		t.Fatalf("RenderAll() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("formatter ran %d times, want 2 (once per cell)", calls)
+	}
+	for _, f := range formats {
+		o := opts
+		o.Format = f
+		o.Formatters = nil
+		want, err := tablewriter.Render(o, [][]string{{"bob", ""}, {"alice", "a@example.com"}})
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		if outs[f] != want {
+			t.Errorf("RenderAll()[%s] = %q, want %q", f, outs[f], want)
+		}
+	}
+}
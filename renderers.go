@@ -0,0 +1,204 @@
+package tablewriter
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+)
+
+// renderPlain renders rows as a table drawn with box-drawing characters:
+//
+//	┌───────┬─────┐
+//	│ Name  │ Age │
+//	├───────┼─────┤
+//	│ alice │ 30  │
+//	└───────┴─────┘
+func renderPlain(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	widths, cells, err := formatCells(ctx, opts, rows)
+	if err != nil {
+		return "", err
+	}
+	rule := func(left, mid, right string) string {
+		parts := make([]string, len(widths))
+		for i, w := range widths {
+			parts[i] = strings.Repeat("─", w+2)
+		}
+		return left + strings.Join(parts, mid) + right + "\n"
+	}
+	var b strings.Builder
+	b.WriteString(rule("┌", "┬", "┐"))
+	if len(opts.Headers) > 0 {
+		b.WriteString(textLine(opts.Headers, widths, opts, "│ ", " │ ", " │"))
+		b.WriteString(rule("├", "┼", "┤"))
+	}
+	for _, r := range cells {
+		b.WriteString(textLine(r, widths, opts, "│ ", " │ ", " │"))
+	}
+	b.WriteString(rule("└", "┴", "┘"))
+	return b.String(), nil
+}
+
+// renderSimple renders rows without borders, with a rule beneath the
+// headers:
+//
+//	Name   Age
+//	─────  ───
+//	alice  30
+func renderSimple(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	widths, cells, err := formatCells(ctx, opts, rows)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if len(opts.Headers) > 0 {
+		b.WriteString(textLine(opts.Headers, widths, opts, "", "  ", ""))
+		parts := make([]string, len(widths))
+		for i, w := range widths {
+			parts[i] = strings.Repeat("─", w)
+		}
+		b.WriteString(strings.Join(parts, "  ") + "\n")
+	}
+	for _, r := range cells {
+		b.WriteString(textLine(r, widths, opts, "", "  ", ""))
+	}
+	return b.String(), nil
+}
+
+// renderMarkdown renders rows as a GitHub-flavored Markdown table, with the
+// column alignments marked in the separator line:
+//
+//	| Name  |  Age |
+//	| ----- | ---: |
+//	| alice |   30 |
+//
+// Every separator cell has at least three dashes, as strict CommonMark/GFM
+// parsers require. Pipes inside cells were escaped by prepareFormat.
+func renderMarkdown(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	widths, cells, err := formatCells(ctx, opts, rows)
+	if err != nil {
+		return "", err
+	}
+	headers := opts.Headers
+	seps := make([]string, len(widths))
+	for i := range widths {
+		seps[i] = markdownSeparator(columnAlign(opts, i), widths[i])
+		if len(headers) > 0 {
+			widths[i] = len(seps[i])
+		}
+	}
+	var b strings.Builder
+	if len(headers) > 0 {
+		b.WriteString(textLine(headers, widths, opts, "| ", " | ", " |"))
+		b.WriteString("| " + strings.Join(seps, " | ") + " |\n")
+	}
+	for _, r := range cells {
+		b.WriteString(textLine(r, widths, opts, "| ", " | ", " |"))
+	}
+	return b.String(), nil
+}
+
+// renderCSV renders rows as RFC 4180 comma-separated values, with the
+// headers as the first record.
+func renderCSV(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	if len(opts.Headers) > 0 {
+		if err := w.Write(opts.Headers); err != nil {
+			return "", err
+		}
+	}
+	for _, r := range rows {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		record := make([]string, len(r))
+		for i, c := range r {
+			v, err := applyCellOpts(c, opts)
+			if err != nil {
+				return "", err
+			}
+			record[i] = v
+		}
+		if err := w.Write(record); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	return b.String(), w.Error()
+}
+
+// renderJSON renders rows as a JSON array of objects keyed by header.
+// Cells missing from short rows are written as empty strings.
+func renderJSON(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	if len(opts.Headers) == 0 {
+		return "", ErrMissingHeaders
+	}
+	out := make([]map[string]string, 0, len(rows))
+	for _, r := range rows {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		obj := make(map[string]string, len(opts.Headers))
+		for i, h := range opts.Headers {
+			v := ""
+			if i < len(r) {
+				v = r[i]
+			}
+			var err error
+			if obj[h], err = applyCellOpts(v, opts); err != nil {
+				return "", err
+			}
+		}
+		out = append(out, obj)
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// formatCells returns the column widths of rows and their cells with the
+// cell options applied. Short rows are padded with empty cells.
+func formatCells(ctx context.Context, opts Options, rows [][]string) ([]int, [][]string, error) {
+	widths, err := colWidths(ctx, opts, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	cells := make([][]string, len(rows))
+	for j, r := range rows {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		cells[j] = make([]string, len(widths))
+		for i, c := range r {
+			if cells[j][i], err = applyCellOpts(c, opts); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return widths, cells, nil
+}
+
+// textLine lays out one line of cells padded to widths, between the left
+// and right edges and joined by sep.
+func textLine(cells []string, widths []int, opts Options, left, sep, right string) string {
+	parts := make([]string, len(widths))
+	for i, w := range widths {
+		c := ""
+		if i < len(cells) {
+			c = cells[i]
+		}
+		parts[i], _ = alignCell(c, w, columnAlign(opts, i))
+	}
+	return left + strings.Join(parts, sep) + right + "\n"
+}
+
+// columnAlign returns the alignment of column i, AlignLeft if unset.
+func columnAlign(opts Options, i int) Alignment {
+	if i < len(opts.Alignments) {
+		return opts.Alignments[i]
+	}
+	return AlignLeft
+}
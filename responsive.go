@@ -0,0 +1,70 @@
+package tablewriter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// renderResponsive drops the lowest-priority columns until the table fits in
+// opts.ResponsiveWidth, then renders it with a note such as
+// "+2 columns hidden". At least one column is always kept.
+func renderResponsive(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	widths, err := colWidths(ctx, opts, rows)
+	if err != nil {
+		return "", err
+	}
+	hidden := columnsToHide(opts.Format, widths, opts.ColumnPriorities, opts.ResponsiveWidth)
+	if len(hidden) == 0 {
+		return renderFormat(ctx, opts, rows)
+	}
+
+	opts, rows = selectColumns(opts, rows, func(col int) bool { return !hidden[col] })
+	out, err := renderFormat(ctx, opts, rows)
+	if err != nil {
+		return "", err
+	}
+	noun := "columns"
+	if len(hidden) == 1 {
+		noun = "column"
+	}
+	return strings.TrimSuffix(out, "\n") + fmt.Sprintf("\n+%d %s hidden\n", len(hidden), noun), nil
+}
+
+// columnsToHide returns the set of column indexes to drop, in priority order,
+// so the remaining columns fit within limit.
+func columnsToHide(f Format, widths []int, priorities []int, limit int) map[int]bool {
+	order := make([]int, len(widths))
+	for i := range order {
+		order[i] = i
+	}
+	priority := func(col int) int {
+		if col < len(priorities) {
+			return priorities[col]
+		}
+		return 0
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		pa, pb := priority(order[a]), priority(order[b])
+		if pa != pb {
+			return pa < pb
+		}
+		return order[a] > order[b]
+	})
+
+	hidden := map[int]bool{}
+	for _, col := range order {
+		var kept []int
+		for i, w := range widths {
+			if !hidden[i] {
+				kept = append(kept, w)
+			}
+		}
+		if len(kept) <= 1 || tableWidth(f, kept) <= limit {
+			break
+		}
+		hidden[col] = true
+	}
+	return hidden
+}
@@ -0,0 +1,52 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestResponsiveWidth(t *testing.T) {
+	headers := []string{"Name", "Status", "Description", "Owner"}
+	rows := [][]string{{"api", "ok", "public gateway", "ops"}}
+	tests := []struct {
+		name       string
+		width      int
+		priorities []int
+		want       []string
+		wantHidden []string
+		note       string
+	}{
+		{"fits", 200, nil, headers, nil, ""},
+		{"drop rightmost at equal priority", 30, nil, []string{"Name", "Status"}, []string{"Description", "Owner"}, "+2 columns hidden"},
+		{"drop lowest priority", 40, []int{3, 2, 0, 1}, []string{"Name", "Status", "Owner"}, []string{"Description"}, "+1 column hidden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:          headers,
+				Format:           tablewriter.FormatMarkdown,
+				ResponsiveWidth:  tt.width,
+				ColumnPriorities: tt.priorities,
+			}
+			out, err := tablewriter.Render(opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			for _, h := range tt.want {
+				if !strings.Contains(out, h) {
+					t.Errorf("Render() should contain %q:\n%s", h, out)
+				}
+			}
+			for _, h := range tt.wantHidden {
+				if strings.Contains(out, h) {
+					t.Errorf("Render() should not contain %q:\n%s", h, out)
+				}
+			}
+			if tt.note != "" && !strings.HasSuffix(out, tt.note+"\n") {
+				t.Errorf("Render() should end with %q:\n%s", tt.note, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRowFilter(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Job", "Status"}, Format: tablewriter.FormatCSV})
+	for _, r := range [][]string{{"a", "ok"}, {"b", "error"}, {"c", "ok"}, {"d", "error"}} {
+		if err := tbl.AddRow(r...); err != nil {
+			t.Fatalf("AddRow() error = %v", err)
+		}
+	}
+	views := map[string]func([]string) bool{
+		"Job,Status\na,ok\nb,error\nc,ok\nd,error\n": nil,
+		"Job,Status\nb,error\nd,error\n":             func(r []string) bool { return r[1] == "error" },
+		"Job,Status\n":                               func([]string) bool { return false },
+	}
+	var wg sync.WaitGroup
+	for want, filter := range views {
+		wg.Add(1)
+		go func(want string, filter func([]string) bool) {
+			defer wg.Done()
+			opts := tbl.Options()
+			opts.RowFilter = filter
+			got, err := tbl.RenderWith(opts)
+			if err != nil {
+				t.Errorf("RenderWith() error = %v", err)
+				return
+			}
+			if got != want {
+				t.Errorf("RenderWith() = %q, want %q", got, want)
+			}
+		}(want, filter)
+	}
+	wg.Wait()
+	if n := tbl.RowCount(); n != 4 {
+		t.Errorf("RowCount() = %d after filtered renders, want 4", n)
+	}
+}
+
+func TestRowFilterBeforeLimit(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers:   []string{"N"},
+		Format:    tablewriter.FormatCSV,
+		Limit:     2,
+		RowFilter: func(r []string) bool { return strings.HasPrefix(r[0], "x") },
+	}
+	got, err := tablewriter.Render(opts, [][]string{{"a"}, {"x1"}, {"b"}, {"x2"}, {"x3"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "N\nx1\nx2\n"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestRenderWithConcurrentCache(t *testing.T) {
+	rows := [][]string{{"a", "ok"}, {"b", "error"}}
+	want, _ := tablewriter.Render(tablewriter.Options{Headers: []string{"Job", "Status"}}, rows)
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Job", "Status"}, CacheWidths: true})
+	tbl.AddRows(rows)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			opts := tbl.Options()
+			opts.RowFilter = func(r []string) bool { return r[1] == "error" }
+			if _, err := tbl.RenderWith(opts); err != nil {
+				t.Errorf("RenderWith() error = %v", err)
+			}
+			if got := tbl.Render(); got != want {
+				t.Errorf("Render() = %q, want %q", got, want)
+			}
+			if _, _, err := tbl.ColumnWidths(); err != nil {
+				t.Errorf("ColumnWidths() error = %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
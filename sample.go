@@ -0,0 +1,52 @@
+package tablewriter
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Sample renders a random subset of n rows, in their table order, for
+// eyeballing large datasets. The same seed always selects the same rows
+// from the same table. If n is at least the row count, every row is
+// rendered.
+//
+// Example:
+//
+//	out, err := t.Sample(20, 42)
+func (t *Table) Sample(n int, seed int64) (string, error) {
+	idx := sampleIndexes(len(t.rows), n, seed)
+	rows := make([][]string, len(idx))
+	meta := make([]RowMeta, len(idx))
+	for i, j := range idx {
+		rows[i], meta[i] = t.rows[j], t.meta[j]
+	}
+	opts := t.opts
+	if len(opts.Footnotes) > 0 {
+		opts.Footnotes = resolveFootnotes(opts.Footnotes, idx)
+	}
+	opts, rows, err := prepare(opts, rows, meta)
+	if err != nil {
+		return "", err
+	}
+	return render(opts, rows)
+}
+
+// sampleIndexes returns min(n, total) distinct indexes below total, chosen
+// pseudo-randomly from seed, in ascending order. It uses Floyd's algorithm,
+// so it costs O(n log n) time and O(n) memory whatever total is.
+func sampleIndexes(total, n int, seed int64) []int {
+	n = max(min(n, total), 0)
+	r := rand.New(rand.NewSource(seed))
+	chosen := make(map[int]bool, n)
+	idx := make([]int, 0, n)
+	for j := total - n; j < total; j++ {
+		k := r.Intn(j + 1)
+		if chosen[k] {
+			k = j
+		}
+		chosen[k] = true
+		idx = append(idx, k)
+	}
+	sort.Ints(idx)
+	return idx
+}
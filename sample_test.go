@@ -0,0 +1,54 @@
+package tablewriter_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestSample(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Format: tablewriter.FormatCSV})
+	for i := 0; i < 100; i++ {
+		tbl.AddRow(strconv.Itoa(i))
+	}
+
+	first, err := tbl.Sample(5, 42)
+	if err != nil {
+		t.Fatalf("Sample() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(first, "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("Sample(5) rendered %d rows, want 5:\n%s", len(lines), first)
+	}
+	for i := 1; i < len(lines); i++ {
+		a, _ := strconv.Atoi(lines[i-1])
+		b, _ := strconv.Atoi(lines[i])
+		if a >= b {
+			t.Errorf("Sample() rows out of table order: %q", lines)
+		}
+	}
+	if again, _ := tbl.Sample(5, 42); again != first {
+		t.Errorf("Sample() with same seed = %q, want %q", again, first)
+	}
+	if other, _ := tbl.Sample(5, 7); other == first {
+		t.Errorf("Sample() with different seed = %q, want a different subset", other)
+	}
+	if all, _ := tbl.Sample(500, 1); strings.Count(all, "\n") != 100 {
+		t.Errorf("Sample(500) rendered %d rows, want 100", strings.Count(all, "\n"))
+	}
+}
+
+func BenchmarkSample(b *testing.B) {
+	tbl := tablewriter.New(tablewriter.Options{Format: tablewriter.FormatCSV})
+	for i := 0; i < 100000; i++ {
+		tbl.AddRow(strconv.Itoa(i))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := tbl.Sample(10, int64(i)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package tablewriter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrInvalidSchema is returned when a JSON Schema document cannot be parsed.
+var ErrInvalidSchema = errors.New("tablewriter: invalid JSON schema")
+
+// ErrSchemaViolation is returned when a row does not match Options.Schema.
+var ErrSchemaViolation = errors.New("tablewriter: row does not match schema")
+
+// Schema is the subset of JSON Schema used to validate rows: the keywords
+// type, enum, properties, required, additionalProperties (as a boolean),
+// items, minLength, maxLength, pattern, minimum, maximum, and format
+// ("date-time" and "date"). Other keywords are ignored. It can be parsed
+// from a document with ParseJSONSchema or built in Go.
+type Schema struct {
+	SchemaURI            string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 SchemaType         `json:"type,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	Format               string             `json:"format,omitempty"`
+}
+
+// SchemaType lists the JSON types a Schema allows, such as "string" or
+// "integer". It is written as a single string when it holds one type and
+// as an array otherwise, and read from either.
+type SchemaType []string
+
+// MarshalJSON implements json.Marshaler.
+func (t SchemaType) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *SchemaType) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*t = SchemaType{one}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(t))
+}
+
+// ParseJSONSchema parses a JSON Schema document describing one row object,
+// or an array of them, for Options.Schema.
+//
+// Example:
+//
+//	schema, err := tablewriter.ParseJSONSchema([]byte(`{
+//	    "type": "object",
+//	    "required": ["id"],
+//	    "properties": {"id": {"type": "integer"}, "email": {"type": "string", "pattern": "@"}}
+//	}`))
+func ParseJSONSchema(data []byte) (*Schema, error) {
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+	if err := s.compile(map[*Schema]*regexp.Regexp{}); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// compile adds the compiled patterns of s and its subschemas to patterns.
+func (s *Schema) compile(patterns map[*Schema]*regexp.Regexp) error {
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("%w: pattern %q: %v", ErrInvalidSchema, s.Pattern, err)
+		}
+		patterns[s] = re
+	}
+	for _, p := range s.Properties {
+		if p == nil {
+			continue
+		}
+		if err := p.compile(patterns); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		return s.Items.compile(patterns)
+	}
+	return nil
+}
+
+// validateRows checks every row, as FormatJSON would encode it, against
+// opts.Schema. A schema of type "array" validates rows against its items.
+func validateRows(opts Options, rows [][]string) error {
+	s := opts.Schema
+	if s.Items != nil && s.allows("array") {
+		s = s.Items
+	}
+	patterns := map[*Schema]*regexp.Regexp{}
+	if err := s.compile(patterns); err != nil {
+		return err
+	}
+	for r, row := range rows {
+		obj := map[string]any{}
+		for i, h := range opts.Headers {
+			v := cellAt(row, i)
+			if v == Null {
+				v = ""
+			}
+			var cell any = v
+			if t := typeAt(opts, i); t != TypeString && t != TypeTime {
+				cell = t.jsonValue(v)
+			}
+			path := []string{h}
+			if opts.NestedJSON {
+				path = strings.Split(h, ".")
+			}
+			if err := setPath(obj, path, cell); err != nil {
+				return fmt.Errorf("%w: %q", err, h)
+			}
+		}
+		if msg := s.validate(obj, "", patterns); msg != "" {
+			return fmt.Errorf("%w: row %d: %s", ErrSchemaViolation, r, msg)
+		}
+	}
+	return nil
+}
+
+// validate checks the JSON value v against s and describes the first
+// violation, or returns "" if there is none. path names v in the message,
+// and patterns holds the compiled patterns of s and its subschemas.
+func (s *Schema) validate(v any, path string, patterns map[*Schema]*regexp.Regexp) string {
+	at := func(format string, args ...any) string {
+		msg := fmt.Sprintf(format, args...)
+		if path == "" {
+			return msg
+		}
+		return path + ": " + msg
+	}
+	if len(s.Type) > 0 && !s.allowsValue(v) {
+		return at("%s is not of type %s", jsonText(v), strings.Join(s.Type, " or "))
+	}
+	if len(s.Enum) > 0 && !enumContains(s.Enum, v) {
+		return at("%s is not one of %s", jsonText(v), jsonText(s.Enum))
+	}
+	switch x := v.(type) {
+	case string:
+		n := utf8.RuneCountInString(x)
+		if s.MinLength != nil && n < *s.MinLength {
+			return at("%s is shorter than %d", jsonText(v), *s.MinLength)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			return at("%s is longer than %d", jsonText(v), *s.MaxLength)
+		}
+		if s.Pattern != "" && !patterns[s].MatchString(x) {
+			return at("%s does not match %q", jsonText(v), s.Pattern)
+		}
+		if !formatMatches(s.Format, x) {
+			return at("%s is not a valid %s", jsonText(v), s.Format)
+		}
+	case int64, float64:
+		f := toFloat(x)
+		if s.Minimum != nil && f < *s.Minimum {
+			return at("%s is less than %v", jsonText(v), *s.Minimum)
+		}
+		if s.Maximum != nil && f > *s.Maximum {
+			return at("%s is greater than %v", jsonText(v), *s.Maximum)
+		}
+	case map[string]any:
+		for _, k := range s.Required {
+			if _, ok := x[k]; !ok {
+				return at("missing required property %q", k)
+			}
+		}
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p, ok := s.Properties[k]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					return at("property %q is not allowed", k)
+				}
+				continue
+			}
+			if p == nil {
+				continue
+			}
+			if msg := p.validate(x[k], strings.TrimPrefix(path+"."+k, "."), patterns); msg != "" {
+				return msg
+			}
+		}
+	}
+	return ""
+}
+
+// allows reports whether s permits the JSON type name.
+func (s *Schema) allows(name string) bool {
+	for _, t := range s.Type {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsValue reports whether the type of v is among s.Type.
+func (s *Schema) allowsValue(v any) bool {
+	switch x := v.(type) {
+	case nil:
+		return s.allows("null")
+	case string:
+		return s.allows("string")
+	case bool:
+		return s.allows("boolean")
+	case int64:
+		return s.allows("integer") || s.allows("number")
+	case float64:
+		return s.allows("number") || (s.allows("integer") && x == math.Trunc(x) && !math.IsInf(x, 0))
+	case map[string]any:
+		return s.allows("object")
+	default:
+		return false
+	}
+}
+
+// enumContains reports whether v equals one of the enum values, comparing
+// numbers by value.
+func enumContains(enum []any, v any) bool {
+	for _, e := range enum {
+		switch x := e.(type) {
+		case float64, int64, int:
+			if n, ok := v.(int64); ok && toFloat(x) == float64(n) {
+				return true
+			}
+			if f, ok := v.(float64); ok && toFloat(x) == f {
+				return true
+			}
+		default:
+			if e == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// formatMatches reports whether s is valid for a JSON Schema string format.
+// Unknown formats always match.
+func formatMatches(format, s string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, s)
+		return err == nil
+	case "date":
+		_, err := time.Parse("2006-01-02", s)
+		return err == nil
+	default:
+		return true
+	}
+}
+
+// toFloat converts a JSON number to float64.
+func toFloat(v any) float64 {
+	switch x := v.(type) {
+	case int64:
+		return float64(x)
+	case int:
+		return float64(x)
+	case float64:
+		return x
+	default:
+		return math.NaN()
+	}
+}
+
+// jsonText returns v encoded as JSON, for error messages.
+func jsonText(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// WithSchema returns a copy of Options that validates every row against s
+// before rendering; nil removes validation. Returns ErrInvalidSchema if a
+// pattern in s does not compile.
+//
+// Example:
+//
+//	schema, err := tablewriter.ParseJSONSchema(data)
+//	opts, err := tablewriter.DefaultOptions().WithSchema(schema)
+func (o Options) WithSchema(s *Schema) (Options, error) {
+	if s != nil {
+		if err := s.compile(map[*Schema]*regexp.Regexp{}); err != nil {
+			return o, err
+		}
+	}
+	o.Schema = s
+	return o, nil
+}
@@ -0,0 +1,94 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+const userSchema = `{
+	"type": "array",
+	"items": {
+		"type": "object",
+		"required": ["id", "email"],
+		"additionalProperties": false,
+		"properties": {
+			"id": {"type": "integer", "minimum": 1},
+			"email": {"type": "string", "pattern": "^[^@]+@[^@]+$"},
+			"role": {"enum": ["admin", "user"]},
+			"age": {"type": ["integer", "null"], "maximum": 150},
+			"joined": {"type": "string", "format": "date"}
+		}
+	}
+}`
+
+func TestSchemaValidation(t *testing.T) {
+	schema, err := tablewriter.ParseJSONSchema([]byte(userSchema))
+	if err != nil {
+		t.Fatalf("ParseJSONSchema() error = %v", err)
+	}
+	types := []tablewriter.ColumnType{tablewriter.TypeInt, tablewriter.TypeString, tablewriter.TypeString, tablewriter.TypeInt}
+	tests := []struct {
+		name    string
+		headers []string
+		row     []string
+		want    string // "" means valid
+	}{
+		{"valid", []string{"id", "email", "role", "age", "joined"}, []string{"1", "a@example.com", "admin", "", "2024-01-02"}, ""},
+		{"minimum", []string{"id", "email"}, []string{"0", "a@example.com"}, "id: 0 is less than 1"},
+		{"not integer", []string{"id", "email"}, []string{"x", "a@example.com"}, `id: "x" is not of type integer`},
+		{"pattern", []string{"id", "email"}, []string{"1", "nobody"}, `email: "nobody" does not match`},
+		{"enum", []string{"id", "email", "role"}, []string{"1", "a@b", "root"}, `role: "root" is not one of ["admin","user"]`},
+		{"maximum", []string{"id", "email", "role", "age"}, []string{"1", "a@b", "user", "200"}, "age: 200 is greater than 150"},
+		{"format", []string{"id", "email", "role", "age", "joined"}, []string{"1", "a@b", "user", "", "yesterday"}, `joined: "yesterday" is not a valid date`},
+		{"required", []string{"id"}, []string{"1"}, `missing required property "email"`},
+		{"additional", []string{"id", "email", "extra"}, []string{"1", "a@b", "x"}, `property "extra" is not allowed`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := tablewriter.New(tablewriter.Options{
+				Format:      tablewriter.FormatMarkdown,
+				Headers:     tt.headers,
+				ColumnTypes: types,
+				Schema:      schema,
+			})
+			if err := tbl.AddRow(tt.row...); err != nil {
+				t.Fatal(err)
+			}
+			_, err := tbl.RenderErr()
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("RenderErr() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tablewriter.ErrSchemaViolation) || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("RenderErr() error = %v, want ErrSchemaViolation containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJSONSchemaErrors(t *testing.T) {
+	for _, in := range []string{`{"type": 3}`, `{"properties": {"a": {"pattern": "("}}}`, `not json`} {
+		if _, err := tablewriter.ParseJSONSchema([]byte(in)); !errors.Is(err, tablewriter.ErrInvalidSchema) {
+			t.Errorf("ParseJSONSchema(%q) error = %v, want ErrInvalidSchema", in, err)
+		}
+	}
+}
+
+func TestWithSchema(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithSchema(&tablewriter.Schema{Pattern: "("}); !errors.Is(err, tablewriter.ErrInvalidSchema) {
+		t.Errorf("WithSchema(bad pattern) error = %v, want %v", err, tablewriter.ErrInvalidSchema)
+	}
+	schema, err := tablewriter.ParseJSONSchema([]byte(userSchema))
+	if err != nil {
+		t.Fatalf("ParseJSONSchema() error = %v", err)
+	}
+	opts, err := tablewriter.DefaultOptions().WithSchema(schema)
+	if err != nil || opts.Schema != schema {
+		t.Errorf("WithSchema() = %v, %v, want the schema", opts.Schema, err)
+	}
+}
@@ -0,0 +1,45 @@
+package tablewriter
+
+import "fmt"
+
+// Reverse reverses the order of the table's rows, so the most recently
+// added row comes first.
+//
+// Example:
+//
+//	t.Reverse()
+//	out := t.Render()
+func (t *Table) Reverse() {
+	for i, j := 0, len(t.rows)-1; i < j; i, j = i+1, j-1 {
+		t.rows[i], t.rows[j] = t.rows[j], t.rows[i]
+		t.meta[i], t.meta[j] = t.meta[j], t.meta[i]
+	}
+	t.keys = nil
+	t.widths.reset()
+}
+
+// WithOffsetLimit returns a copy of Options that renders at most limit rows
+// after skipping the first offset. A limit of 0 means no limit.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithOffsetLimit(0, 20)
+func (o Options) WithOffsetLimit(offset, limit int) (Options, error) {
+	if offset < 0 || limit < 0 {
+		return o, fmt.Errorf("invalid offset %d or limit %d: %w", offset, limit, ErrInvalidOptions)
+	}
+	o.Offset = offset
+	o.Limit = limit
+	return o, nil
+}
+
+// pageRows returns the rows selected by opts.Offset and opts.Limit, and
+// their source indexes from src.
+func pageRows(opts Options, rows [][]string, src []int) ([][]string, []int) {
+	start := min(max(opts.Offset, 0), len(rows))
+	end := len(rows)
+	if opts.Limit > 0 && opts.Limit < end-start {
+		end = start + opts.Limit
+	}
+	return rows[start:end], src[start:end]
+}
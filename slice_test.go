@@ -0,0 +1,46 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestOffsetLimit(t *testing.T) {
+	tests := []struct {
+		name          string
+		reverse       bool
+		offset, limit int
+		want          string
+	}{
+		{"all", false, 0, 0, "1\n2\n3\n4\n"},
+		{"limit", false, 0, 2, "1\n2\n"},
+		{"offset", false, 3, 0, "4\n"},
+		{"page", false, 1, 2, "2\n3\n"},
+		{"past end", false, 9, 2, ""},
+		{"latest two", true, 0, 2, "4\n3\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := tablewriter.Options{Format: tablewriter.FormatCSV}.WithOffsetLimit(tt.offset, tt.limit)
+			if err != nil {
+				t.Fatalf("WithOffsetLimit() error = %v", err)
+			}
+			tbl := tablewriter.New(opts)
+			tbl.AddRows([][]string{{"1"}, {"2"}, {"3"}, {"4"}})
+			if tt.reverse {
+				tbl.Reverse()
+			}
+			if out := tbl.Render(); out != tt.want {
+				t.Errorf("Render() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithOffsetLimitInvalid(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithOffsetLimit(-1, 0); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithOffsetLimit(-1, 0) error = %v, want %v", err, tablewriter.ErrInvalidOptions)
+	}
+}
@@ -0,0 +1,243 @@
+package tablewriter
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrUnknownColumn is returned when an option names a column that is not
+// among the table's headers.
+var ErrUnknownColumn = errors.New("tablewriter: unknown column")
+
+// SortMode controls how cell values are compared when sorting rows. Modes
+// other than SortLexical are flags and may be combined, e.g.
+// SortNatural|SortFoldCase.
+type SortMode int
+
+const (
+	SortLexical  SortMode = 0               // SortLexical compares values byte by byte (default).
+	SortNatural  SortMode = 1 << (iota - 1) // SortNatural compares runs of digits numerically, so "v1.2" < "v1.10".
+	SortFoldCase                            // SortFoldCase ignores letter case, so "apple" < "Banana".
+	SortCollate                             // SortCollate ignores case and accents first, so "apple" < "Ärger" < "Zebra".
+)
+
+// compare returns -1, 0, or +1 as a sorts before, with, or after b. Values
+// equal under case folding or collation fall back to byte order, so the
+// result is deterministic.
+func (m SortMode) compare(a, b string) int {
+	cmp := strings.Compare
+	if m&SortNatural != 0 {
+		cmp = naturalCompare
+	}
+	if m&SortCollate != 0 {
+		if c := cmp(collationKey(a), collationKey(b)); c != 0 {
+			return c
+		}
+	}
+	if m&(SortFoldCase|SortCollate) != 0 {
+		if c := cmp(strings.ToLower(a), strings.ToLower(b)); c != 0 {
+			return c
+		}
+	}
+	return cmp(a, b)
+}
+
+// SortKey is one level of a multi-key sort.
+type SortKey struct {
+	// Column is the header of the column to compare.
+	Column string
+
+	// Descending reverses the order for this key.
+	Descending bool
+
+	// Mode selects how values are compared.
+	Mode SortMode
+}
+
+// WithSortBy returns a copy of Options that sorts rows by the named column.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithSortBy("Host", tablewriter.SortNatural)
+func (o Options) WithSortBy(column string, mode SortMode) Options {
+	o.SortBy = column
+	o.SortMode = mode
+	return o
+}
+
+// WithSortKeys returns a copy of Options that sorts rows by several keys,
+// the first key taking precedence.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithSortKeys(
+//	    tablewriter.SortKey{Column: "Status", Descending: true},
+//	    tablewriter.SortKey{Column: "Name"},
+//	)
+func (o Options) WithSortKeys(keys ...SortKey) Options {
+	o.SortKeys = keys
+	return o
+}
+
+// WithSortFunc returns a copy of Options that sorts rows with a custom
+// comparator.
+//
+// Example:
+//
+//	severity := map[string]int{"critical": 0, "warning": 1, "info": 2}
+//	opts := tablewriter.DefaultOptions().WithSortFunc(func(a, b []string) int {
+//	    return severity[a[0]] - severity[b[0]]
+//	})
+func (o Options) WithSortFunc(f func(a, b []string) int) Options {
+	o.SortFunc = f
+	return o
+}
+
+// sortKeys returns the keys rows are sorted by: SortKeys if set, otherwise
+// a single ascending key built from SortBy and SortMode.
+func sortKeys(opts Options) []SortKey {
+	if len(opts.SortKeys) > 0 {
+		return opts.SortKeys
+	}
+	if opts.SortBy != "" {
+		return []SortKey{{Column: opts.SortBy, Mode: opts.SortMode}}
+	}
+	return nil
+}
+
+// sortRows stably sorts rows by keys, moving their source indexes in src
+// along with them. Rows that compare equal on every key keep their
+// insertion order. Keys with SortLexical on a typed column compare by value.
+func sortRows(opts Options, rows [][]string, src []int, keys []SortKey) error {
+	cols := make([]int, len(keys))
+	for k, key := range keys {
+		if cols[k] = indexOf(opts.Headers, key.Column); cols[k] < 0 {
+			return fmt.Errorf("%w: %q", ErrUnknownColumn, key.Column)
+		}
+	}
+	sort.Stable(sourcedRows{rows, src, func(ra, rb []string) bool {
+		for k, key := range keys {
+			a, b := cellAt(ra, cols[k]), cellAt(rb, cols[k])
+			var c int
+			if t := typeAt(opts, cols[k]); key.Mode == SortLexical && t != TypeString {
+				c = t.compare(a, b)
+			} else {
+				c = key.Mode.compare(a, b)
+			}
+			if key.Descending {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	}})
+	return nil
+}
+
+// sortRowsFunc stably sorts rows with cmp, moving their source indexes in
+// src along with them.
+func sortRowsFunc(rows [][]string, src []int, cmp func(a, b []string) int) {
+	sort.Stable(sourcedRows{rows, src, func(a, b []string) bool { return cmp(a, b) < 0 }})
+}
+
+// sourcedRows sorts rows by less, keeping src, the source index of each
+// row, in step.
+type sourcedRows struct {
+	rows [][]string
+	src  []int
+	less func(a, b []string) bool
+}
+
+func (s sourcedRows) Len() int           { return len(s.rows) }
+func (s sourcedRows) Less(i, j int) bool { return s.less(s.rows[i], s.rows[j]) }
+func (s sourcedRows) Swap(i, j int) {
+	s.rows[i], s.rows[j] = s.rows[j], s.rows[i]
+	s.src[i], s.src[j] = s.src[j], s.src[i]
+}
+
+// indexOf returns the index of the first header equal to name, or -1.
+func indexOf(headers []string, name string) int {
+	for i, h := range headers {
+		if h == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// cellAt returns row[col], or "" for a short row.
+func cellAt(row []string, col int) string {
+	if col < len(row) {
+		return row[col]
+	}
+	return ""
+}
+
+// naturalCompare compares a and b treating each run of ASCII digits as a
+// number. Numbers that are equal in value but differ in leading zeros fall
+// back to byte order, so the comparison is still total.
+func naturalCompare(a, b string) int {
+	for a != "" && b != "" {
+		da, db := digitPrefix(a), digitPrefix(b)
+		if da == "" || db == "" {
+			if a[0] != b[0] {
+				return strings.Compare(a[:1], b[:1])
+			}
+			a, b = a[1:], b[1:]
+			continue
+		}
+		na, nb := strings.TrimLeft(da, "0"), strings.TrimLeft(db, "0")
+		if len(na) != len(nb) {
+			if len(na) < len(nb) {
+				return -1
+			}
+			return 1
+		}
+		if c := strings.Compare(na, nb); c != 0 {
+			return c
+		}
+		if c := strings.Compare(da, db); c != 0 {
+			return c
+		}
+		a, b = a[len(da):], b[len(db):]
+	}
+	return strings.Compare(a, b)
+}
+
+// digitPrefix returns the leading run of ASCII digits in s.
+func digitPrefix(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return s[:i]
+}
+
+// sortModeNames maps each SortMode to its name in configuration files.
+var sortModeNames = map[SortMode]string{
+	SortLexical:  "lexical",
+	SortNatural:  "natural",
+	SortFoldCase: "fold-case",
+	SortCollate:  "collate",
+}
+
+// MarshalText encodes m as the names of its flags joined by "|", e.g.
+// "natural|fold-case", implementing encoding.TextMarshaler.
+func (m SortMode) MarshalText() ([]byte, error) {
+	return marshalFlags(m, sortModeNames, "SortMode")
+}
+
+// UnmarshalText parses the names written by MarshalText, ignoring case,
+// implementing encoding.TextUnmarshaler.
+func (m *SortMode) UnmarshalText(text []byte) error {
+	v, err := unmarshalFlags(text, sortModeNames, "SortMode")
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
@@ -0,0 +1,117 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestSortBy(t *testing.T) {
+	rows := [][]string{{"host10", "v1.10"}, {"host9", "v1.2"}, {"host1", "v1.9"}}
+	tests := []struct {
+		name string
+		by   string
+		mode tablewriter.SortMode
+		want string
+	}{
+		{"lexical", "Host", tablewriter.SortLexical, "host1,v1.9\nhost10,v1.10\nhost9,v1.2\n"},
+		{"natural hosts", "Host", tablewriter.SortNatural, "host1,v1.9\nhost9,v1.2\nhost10,v1.10\n"},
+		{"natural versions", "Version", tablewriter.SortNatural, "host9,v1.2\nhost1,v1.9\nhost10,v1.10\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Headers: []string{"Host", "Version"}, Format: tablewriter.FormatCSV}
+			out, err := tablewriter.Render(opts.WithSortBy(tt.by, tt.mode), rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("Render() = %q, want it to contain %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortByUnknownColumn(t *testing.T) {
+	opts := tablewriter.Options{Headers: []string{"Host"}, SortBy: "Name"}
+	if _, err := tablewriter.Render(opts, [][]string{{"a"}}); !errors.Is(err, tablewriter.ErrUnknownColumn) {
+		t.Errorf("Render() error = %v, want %v", err, tablewriter.ErrUnknownColumn)
+	}
+}
+
+func TestSortKeys(t *testing.T) {
+	rows := [][]string{
+		{"carol", "ok"},
+		{"alice", "failed"},
+		{"bob", "ok"},
+		{"dave", "failed"},
+		{"alice", "ok"},
+	}
+	opts := tablewriter.Options{Headers: []string{"Name", "Status"}, Format: tablewriter.FormatCSV}.WithSortKeys(
+		tablewriter.SortKey{Column: "Status", Descending: true},
+		tablewriter.SortKey{Column: "Name"},
+	)
+	out, err := tablewriter.Render(opts, rows)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	want := "alice,ok\nbob,ok\ncarol,ok\nalice,failed\ndave,failed\n"
+	if !strings.Contains(out, want) {
+		t.Errorf("Render() = %q, want it to contain %q", out, want)
+	}
+}
+
+func TestSortFunc(t *testing.T) {
+	severity := map[string]int{"critical": 0, "warning": 1, "info": 2}
+	opts := tablewriter.Options{Format: tablewriter.FormatCSV}.WithSortFunc(func(a, b []string) int {
+		return severity[a[0]] - severity[b[0]]
+	})
+	rows := [][]string{{"info", "1"}, {"critical", "2"}, {"warning", "3"}, {"critical", "4"}}
+	out, err := tablewriter.Render(opts, rows)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	want := "critical,2\ncritical,4\nwarning,3\ninfo,1\n"
+	if out != want {
+		t.Errorf("Render() = %q, want %q", out, want)
+	}
+}
+
+func TestSortModeFolding(t *testing.T) {
+	rows := [][]string{{"Zebra"}, {"Ärger"}, {"apple"}, {"Banana"}}
+	tests := []struct {
+		name string
+		mode tablewriter.SortMode
+		want string
+	}{
+		{"lexical", tablewriter.SortLexical, "Banana\nZebra\napple\nÄrger\n"},
+		{"fold case", tablewriter.SortFoldCase, "apple\nBanana\nZebra\nÄrger\n"},
+		{"collate", tablewriter.SortCollate, "apple\nÄrger\nBanana\nZebra\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Headers: []string{"Word"}, Format: tablewriter.FormatCSV}
+			out, err := tablewriter.Render(opts.WithSortBy("Word", tt.mode), rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.HasSuffix(out, tt.want) {
+				t.Errorf("Render() = %q, want it to end with %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortModeCombined(t *testing.T) {
+	opts := tablewriter.Options{Headers: []string{"Host"}, Format: tablewriter.FormatCSV}.
+		WithSortBy("Host", tablewriter.SortNatural|tablewriter.SortFoldCase)
+	out, err := tablewriter.Render(opts, [][]string{{"Host10"}, {"host9"}, {"HOST1"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "HOST1\nhost9\nHost10\n"; !strings.HasSuffix(out, want) {
+		t.Errorf("Render() = %q, want it to end with %q", out, want)
+	}
+}
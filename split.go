@@ -0,0 +1,81 @@
+package tablewriter
+
+import (
+	"context"
+	"strings"
+)
+
+// renderSplit renders the table as stacked sub-tables no wider than
+// opts.SplitWidth, separated by a blank line. Columns marked in
+// opts.SplitAnchors appear in every chunk. A single column wider than the limit
+// gets a chunk of its own, with the anchors.
+func renderSplit(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	widths, err := colWidths(ctx, opts, rows)
+	if err != nil {
+		return "", err
+	}
+	chunks := splitColumns(opts.Format, widths, opts.SplitWidth, opts.SplitAnchors)
+	if len(chunks) <= 1 {
+		return renderFormat(ctx, opts, rows)
+	}
+
+	parts := make([]string, 0, len(chunks))
+	for _, cols := range chunks {
+		keep := make(map[int]bool, len(cols))
+		for _, c := range cols {
+			keep[c] = true
+		}
+		o, r := selectColumns(opts, cloneRows(rows), func(col int) bool { return keep[col] })
+		out, err := renderFormat(ctx, o, r)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, strings.TrimSuffix(out, "\n"))
+	}
+	return strings.Join(parts, "\n\n") + "\n", nil
+}
+
+// splitColumns greedily groups column indexes into chunks whose rendered
+// width in format f does not exceed limit. Every chunk includes the anchor
+// columns, which are otherwise not grouped.
+func splitColumns(f Format, widths []int, limit int, anchors []bool) [][]int {
+	var lead []int
+	var leadWidths []int
+	for i, w := range widths {
+		if i < len(anchors) && anchors[i] {
+			lead = append(lead, i)
+			leadWidths = append(leadWidths, w)
+		}
+	}
+	var chunks [][]int
+	cur := append([]int(nil), lead...)
+	curWidths := append([]int(nil), leadWidths...)
+	for i, w := range widths {
+		if i < len(anchors) && anchors[i] {
+			continue
+		}
+		if len(cur) > len(lead) && tableWidth(f, append(curWidths, w)) > limit {
+			chunks = append(chunks, cur)
+			cur = append([]int(nil), lead...)
+			curWidths = append([]int(nil), leadWidths...)
+		}
+		cur = append(cur, i)
+		curWidths = append(curWidths, w)
+	}
+	if len(cur) > len(lead) || len(chunks) == 0 {
+		chunks = append(chunks, cur)
+	}
+	return chunks
+}
+
+// WithSplitAnchors returns a copy of Options that repeats the columns
+// marked true in every sub-table of a split table.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithSplitWidth(80)
+//	opts = opts.WithSplitAnchors(true)
+func (o Options) WithSplitAnchors(anchors ...bool) Options {
+	o.SplitAnchors = anchors
+	return o
+}
@@ -0,0 +1,70 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestSplitWidth(t *testing.T) {
+	headers := []string{"Alpha", "Bravo", "Charlie", "Delta"}
+	rows := [][]string{{"a1", "b1", "c1", "d1"}}
+	tests := []struct {
+		name       string
+		splitWidth int
+		wantChunks int
+	}{
+		{"fits", 100, 1},
+		{"two chunks", 20, 2},
+		{"one column each", 5, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:    headers,
+				Format:     tablewriter.FormatMarkdown,
+				SplitWidth: tt.splitWidth,
+			}
+			out, err := tablewriter.Render(opts, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got := len(strings.Split(strings.TrimSpace(out), "\n\n")); got != tt.wantChunks {
+				t.Errorf("Render() produced %d chunks, want %d:\n%s", got, tt.wantChunks, out)
+			}
+			for _, h := range headers {
+				if strings.Count(out, h) != 1 {
+					t.Errorf("Render() should contain header %q exactly once:\n%s", h, out)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitAnchors(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers:      []string{"ID", "Alpha", "Bravo", "Charlie"},
+		Format:       tablewriter.FormatMarkdown,
+		SplitWidth:   24,
+		SplitAnchors: []bool{true},
+	}
+	out, err := tablewriter.Render(opts, [][]string{{"r1", "a1", "b1", "c1"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	chunks := strings.Split(strings.TrimSpace(out), "\n\n")
+	if len(chunks) < 2 {
+		t.Fatalf("Render() produced %d chunks, want at least 2:\n%s", len(chunks), out)
+	}
+	for _, c := range chunks {
+		if !strings.HasPrefix(c, "| ID ") || !strings.Contains(c, "| r1 ") {
+			t.Errorf("chunk does not start with the anchor column:\n%s", c)
+		}
+	}
+	for _, h := range []string{"Alpha", "Bravo", "Charlie"} {
+		if strings.Count(out, h) != 1 {
+			t.Errorf("Render() should contain header %q exactly once:\n%s", h, out)
+		}
+	}
+}
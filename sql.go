@@ -0,0 +1,89 @@
+package tablewriter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNilDB is returned when RenderQuery is called with a nil *sql.DB.
+var ErrNilDB = errors.New("tablewriter: database handle is nil")
+
+// RenderQuery executes query against db and renders the result set as a table.
+// Column names from the result set are used as headers unless opts.Headers is
+// already set. SQL NULL values are rendered as empty cells, so
+// opts.NullPlaceholder applies to them.
+//
+// Example:
+//
+//	out, err := tablewriter.RenderQuery(ctx, db,
+//	    tablewriter.Options{Format: tablewriter.FormatMarkdown},
+//	    "SELECT id, name FROM users WHERE active = ?", true,
+//	)
+func RenderQuery(ctx context.Context, db *sql.DB, opts Options, query string, args ...any) (string, error) {
+	if db == nil {
+		return "", ErrNilDB
+	}
+	rs, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return "", fmt.Errorf("tablewriter: query failed: %w", err)
+	}
+	defer rs.Close()
+
+	cols, err := rs.Columns()
+	if err != nil {
+		return "", fmt.Errorf("tablewriter: reading columns: %w", err)
+	}
+	if len(opts.Headers) == 0 {
+		opts.Headers = cols
+	}
+
+	rows, err := scanRows(rs, len(cols))
+	if err != nil {
+		return "", err
+	}
+	return Render(opts, rows)
+}
+
+// scanRows reads every remaining row of rs into string cells.
+func scanRows(rs *sql.Rows, numCols int) ([][]string, error) {
+	rows := [][]string{}
+	vals := make([]any, numCols)
+	ptrs := make([]any, numCols)
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+	for rs.Next() {
+		if err := rs.Scan(ptrs...); err != nil {
+			return nil, fmt.Errorf("tablewriter: scanning row: %w", err)
+		}
+		row := make([]string, numCols)
+		for i, v := range vals {
+			row[i] = formatSQLValue(v)
+		}
+		rows = append(rows, row)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, fmt.Errorf("tablewriter: iterating rows: %w", err)
+	}
+	return rows, nil
+}
+
+// formatSQLValue converts a scanned driver value to its cell text.
+// NULL becomes the empty string.
+func formatSQLValue(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(x)
+	case string:
+		return x
+	case time.Time:
+		return x.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(x)
+	}
+}
@@ -0,0 +1,246 @@
+package tablewriter
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrMissingTableName is returned when SQL output is requested without a
+// table name.
+var ErrMissingTableName = errors.New("tablewriter: SQL output requires a table name")
+
+// SQLDialect selects the database flavor SQL output targets: its column
+// type names, identifier quoting, string escaping, and boolean literals.
+type SQLDialect int
+
+const (
+	DialectGeneric  SQLDialect = iota // DialectGeneric uses ANSI SQL: "ident", 'it''s', TRUE (default).
+	DialectPostgres                   // DialectPostgres targets PostgreSQL: "ident", 'it''s', TRUE.
+	DialectMySQL                      // DialectMySQL targets MySQL and MariaDB in their default SQL mode: `ident`, 'C:\\it''s', TRUE.
+	DialectSQLite                     // DialectSQLite targets SQLite: "ident", 'it''s', 1.
+)
+
+// SQLOptions configures RenderSQL.
+type SQLOptions struct {
+	// Table is the name of the table rows are inserted into. Required.
+	Table string
+
+	// Dialect selects the target database. Defaults to DialectGeneric.
+	Dialect SQLDialect
+
+	// CreateTable emits a CREATE TABLE statement before the INSERT
+	// statements. Column types come from Options.ColumnTypes where declared
+	// and are otherwise inferred from the data; columns without empty cells
+	// are declared NOT NULL.
+	CreateTable bool
+}
+
+// RenderSQL renders rows as one INSERT statement per row, after the same
+// render-time processing as Render. Headers are the column names. Empty
+// cells become NULL; Int, Float, and Bool columns (declared or inferred)
+// are written as unquoted literals.
+//
+// Example:
+//
+//	out, err := tablewriter.RenderSQL(opts, rows, tablewriter.SQLOptions{
+//	    Table:       "users",
+//	    Dialect:     tablewriter.DialectPostgres,
+//	    CreateTable: true,
+//	})
+func RenderSQL(opts Options, rows [][]string, sqlOpts SQLOptions) (string, error) {
+	return renderSQL(opts, rows, nil, sqlOpts)
+}
+
+// RenderSQL renders the table as SQL statements; see the package-level
+// RenderSQL.
+//
+// Example:
+//
+//	out, err := t.RenderSQL(tablewriter.SQLOptions{Table: "users"})
+func (t *Table) RenderSQL(sqlOpts SQLOptions) (string, error) {
+	return renderSQL(t.opts, t.rows, t.meta, sqlOpts)
+}
+
+// renderSQL prepares rows and writes the SQL statements for them.
+func renderSQL(opts Options, rows [][]string, meta []RowMeta, sqlOpts SQLOptions) (string, error) {
+	if sqlOpts.Table == "" {
+		return "", ErrMissingTableName
+	}
+	// Column names are keys, as in JSON: no display labels, and repeated
+	// names are deduplicated.
+	opts.Format = FormatJSON
+	opts, rows, err := prepare(opts, rows, meta)
+	if err != nil {
+		return "", err
+	}
+	if len(opts.Headers) == 0 {
+		return "", ErrMissingHeaders
+	}
+	types := sqlColumnTypes(opts, rows)
+
+	var b strings.Builder
+	if sqlOpts.CreateTable {
+		writeCreateTable(&b, opts.Headers, types, rows, sqlOpts)
+	}
+	d := sqlOpts.Dialect
+	names := make([]string, len(opts.Headers))
+	for i, h := range opts.Headers {
+		names[i] = d.quoteIdent(h)
+	}
+	prefix := "INSERT INTO " + d.quoteIdent(sqlOpts.Table) + " (" + strings.Join(names, ", ") + ") VALUES ("
+	for _, r := range rows {
+		vals := make([]string, len(opts.Headers))
+		for i := range vals {
+			vals[i] = d.literal(cellAt(r, i), types[i])
+		}
+		b.WriteString(prefix + strings.Join(vals, ", ") + ");\n")
+	}
+	return b.String(), nil
+}
+
+// writeCreateTable writes a CREATE TABLE statement for the given columns.
+func writeCreateTable(b *strings.Builder, headers []string, types []ColumnType, rows [][]string, sqlOpts SQLOptions) {
+	d := sqlOpts.Dialect
+	b.WriteString("CREATE TABLE " + d.quoteIdent(sqlOpts.Table) + " (\n")
+	for i, h := range headers {
+		width, nullable := 0, len(rows) == 0
+		for _, r := range rows {
+			v := cellAt(r, i)
+			if v == "" {
+				nullable = true
+			}
+			if n := len([]rune(v)); n > width {
+				width = n
+			}
+		}
+		b.WriteString("  " + d.quoteIdent(h) + " " + sqlTypeName(types[i], d, width))
+		if !nullable {
+			b.WriteString(" NOT NULL")
+		}
+		if i < len(headers)-1 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(");\n")
+}
+
+// sqlColumnTypes returns the type of each column: the declared type if any,
+// otherwise the type inferred from its cells.
+func sqlColumnTypes(opts Options, rows [][]string) []ColumnType {
+	types := make([]ColumnType, len(opts.Headers))
+	for i := range types {
+		if types[i] = typeAt(opts, i); types[i] == TypeString {
+			types[i] = inferColumnType(rows, i)
+		}
+	}
+	return types
+}
+
+// inferColumnType returns the narrowest type that every non-empty cell of
+// column col parses as, trying Int, Float, Bool, and Time in turn. Columns
+// with no non-empty cells are TypeString.
+func inferColumnType(rows [][]string, col int) ColumnType {
+	for _, t := range []ColumnType{TypeInt, TypeFloat, TypeBool, TypeTime} {
+		seen, ok := false, true
+		for _, r := range rows {
+			v := cellAt(r, col)
+			if v == "" {
+				continue
+			}
+			seen = true
+			if _, ok = t.parse(v); !ok {
+				break
+			}
+		}
+		if seen && ok {
+			return t
+		}
+	}
+	return TypeString
+}
+
+// sqlTypeName returns the column type name for t in dialect d. width is the
+// longest value in the column, used to size MySQL VARCHAR columns.
+func sqlTypeName(t ColumnType, d SQLDialect, width int) string {
+	switch t {
+	case TypeInt:
+		return "BIGINT"
+	case TypeFloat:
+		switch d {
+		case DialectSQLite:
+			return "REAL"
+		case DialectMySQL:
+			return "DOUBLE"
+		default:
+			return "DOUBLE PRECISION"
+		}
+	case TypeBool:
+		if d == DialectSQLite {
+			return "INTEGER"
+		}
+		return "BOOLEAN"
+	case TypeTime:
+		switch d {
+		case DialectSQLite:
+			return "TEXT"
+		case DialectMySQL:
+			return "DATETIME"
+		default:
+			return "TIMESTAMP"
+		}
+	default:
+		if d == DialectMySQL && width <= 255 {
+			if width == 0 {
+				width = 1
+			}
+			return "VARCHAR(" + strconv.Itoa(width) + ")"
+		}
+		return "TEXT"
+	}
+}
+
+// literal returns v as a SQL literal in dialect d for a column of type t.
+func (d SQLDialect) literal(v string, t ColumnType) string {
+	if v == "" {
+		return "NULL"
+	}
+	if pv, ok := t.parse(v); ok {
+		switch x := pv.(type) {
+		case int64:
+			return strings.TrimSpace(v)
+		case float64:
+			if !math.IsInf(x, 0) && !math.IsNaN(x) {
+				return strings.TrimSpace(v)
+			}
+		case bool:
+			switch {
+			case d == DialectSQLite && x:
+				return "1"
+			case d == DialectSQLite:
+				return "0"
+			case x:
+				return "TRUE"
+			default:
+				return "FALSE"
+			}
+		}
+	}
+	if d == DialectMySQL {
+		// Backslash is an escape character in MySQL string literals.
+		v = strings.ReplaceAll(v, `\`, `\\`)
+	}
+	return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+}
+
+// quoteIdent quotes a SQL identifier for dialect d: with backticks for
+// MySQL, double quotes otherwise.
+func (d SQLDialect) quoteIdent(name string) string {
+	q := `"`
+	if d == DialectMySQL {
+		q = "`"
+	}
+	return q + strings.ReplaceAll(name, q, q+q) + q
+}
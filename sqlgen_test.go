@@ -0,0 +1,87 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRenderSQL(t *testing.T) {
+	opts := tablewriter.Options{Headers: []string{"name", "age", "score", "active"}}
+	rows := [][]string{{"O'Brien", "30", "1.5", "true"}, {"Bob", "", "2", "false"}}
+	tests := []struct {
+		name    string
+		dialect tablewriter.SQLDialect
+		want    []string
+	}{
+		{
+			"postgres",
+			tablewriter.DialectPostgres,
+			[]string{
+				`CREATE TABLE "people" (` + "\n" + `  "name" TEXT NOT NULL,` + "\n" + `  "age" BIGINT,` + "\n" +
+					`  "score" DOUBLE PRECISION NOT NULL,` + "\n" + `  "active" BOOLEAN NOT NULL` + "\n);\n",
+				`INSERT INTO "people" ("name", "age", "score", "active") VALUES ('O''Brien', 30, 1.5, TRUE);`,
+				`VALUES ('Bob', NULL, 2, FALSE);`,
+			},
+		},
+		{
+			"mysql",
+			tablewriter.DialectMySQL,
+			[]string{"`name` VARCHAR(7) NOT NULL", "`score` DOUBLE NOT NULL"},
+		},
+		{
+			"sqlite",
+			tablewriter.DialectSQLite,
+			[]string{`"score" REAL NOT NULL`, `"active" INTEGER NOT NULL`, `VALUES ('O''Brien', 30, 1.5, 1);`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := tablewriter.RenderSQL(opts, rows, tablewriter.SQLOptions{
+				Table:       "people",
+				Dialect:     tt.dialect,
+				CreateTable: true,
+			})
+			if err != nil {
+				t.Fatalf("RenderSQL() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("RenderSQL() = %s\nwant it to contain %s", out, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderSQLMissingTable(t *testing.T) {
+	_, err := tablewriter.RenderSQL(tablewriter.Options{Headers: []string{"a"}}, nil, tablewriter.SQLOptions{})
+	if !errors.Is(err, tablewriter.ErrMissingTableName) {
+		t.Errorf("RenderSQL() error = %v, want %v", err, tablewriter.ErrMissingTableName)
+	}
+}
+
+func TestRenderSQLDialectQuoting(t *testing.T) {
+	opts := tablewriter.Options{Headers: []string{"path`s", `say "hi"`, "ok"}}
+	rows := [][]string{{`C:\it's`, "x", "true"}}
+	tests := []struct {
+		dialect tablewriter.SQLDialect
+		want    string
+	}{
+		{tablewriter.DialectGeneric, `INSERT INTO "t" ("path` + "`" + `s", "say ""hi""", "ok") VALUES ('C:\it''s', 'x', TRUE);`},
+		{tablewriter.DialectPostgres, `INSERT INTO "t" ("path` + "`" + `s", "say ""hi""", "ok") VALUES ('C:\it''s', 'x', TRUE);`},
+		{tablewriter.DialectMySQL, "INSERT INTO `t` (`path``s`, `say \"hi\"`, `ok`) VALUES ('C:\\\\it''s', 'x', TRUE);"},
+		{tablewriter.DialectSQLite, `INSERT INTO "t" ("path` + "`" + `s", "say ""hi""", "ok") VALUES ('C:\it''s', 'x', 1);`},
+	}
+	for _, tt := range tests {
+		out, err := tablewriter.RenderSQL(opts, rows, tablewriter.SQLOptions{Table: "t", Dialect: tt.dialect})
+		if err != nil {
+			t.Fatalf("RenderSQL() error = %v", err)
+		}
+		if got := strings.TrimSuffix(out, "\n"); got != tt.want {
+			t.Errorf("dialect %d: RenderSQL() = %s, want %s", tt.dialect, got, tt.want)
+		}
+	}
+}
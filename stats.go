@@ -0,0 +1,131 @@
+package tablewriter
+
+import "time"
+
+// Stats describes a single render.
+type Stats struct {
+	// Rows is the number of data rows rendered.
+	Rows int
+
+	// Columns is the number of columns rendered.
+	Columns int
+
+	// TruncatedCells is the number of data cells shortened to fit their
+	// columns by MaxColumnWidth, WidthPercentile, ColumnPercents, or
+	// MaxTableWidth. A cell WrapCells splits into lines counts once.
+	TruncatedCells int
+
+	// Bytes is the length of the rendered output.
+	Bytes int
+
+	// Duration is the wall-clock time spent rendering.
+	Duration time.Duration
+}
+
+// RenderStats renders the table like RenderErr and also reports metadata
+// about the render, for services that emit metrics about their tables.
+//
+// Example:
+//
+//	out, stats, err := t.RenderStats()
+//	metrics.Observe("table_render_seconds", stats.Duration.Seconds())
+func (t *Table) RenderStats() (string, Stats, error) {
+	start := time.Now()
+	opts, rows, err := prepare(t.opts, t.rows, t.meta)
+	if err != nil {
+		return "", Stats{}, err
+	}
+	stats := collectStats(opts, rows)
+	opts.cuts = &layoutCuts{}
+	out, err := render(opts, rows)
+	if err != nil {
+		return "", Stats{}, err
+	}
+	stats.TruncatedCells = len(opts.cuts.cut)
+	stats.Bytes = len(out)
+	stats.Duration = time.Since(start)
+	return out, stats, nil
+}
+
+// collectStats counts the rows and columns of prepared rows.
+func collectStats(opts Options, rows [][]string) Stats {
+	s := Stats{Rows: len(rows), Columns: len(opts.Headers)}
+	for _, r := range rows {
+		if len(r) > s.Columns {
+			s.Columns = len(r)
+		}
+	}
+	return s
+}
+
+// layoutCuts records which data cells a render's layout shortens. Cells are
+// identified by the row they had before WrapCells split it into lines, and
+// the methods do nothing on a nil *layoutCuts, as when not collecting Stats.
+type layoutCuts struct {
+	data int   // rows before data are data rows; the rest are footers
+	from []int // from[i] is the unwrapped row of row i
+	cut  map[[2]int]bool
+}
+
+// start begins recording the layout of rows whose first data are data rows.
+func (c *layoutCuts) start(data int) {
+	if c == nil {
+		return
+	}
+	c.data = data
+	c.from = nil
+	c.cut = make(map[[2]int]bool)
+}
+
+// row returns the unwrapped row of row i.
+func (c *layoutCuts) row(i int) int {
+	if c.from == nil {
+		return i
+	}
+	return c.from[i]
+}
+
+// cutAt records that the cell at row i, column j was shortened.
+func (c *layoutCuts) cutAt(i, j int) {
+	if c == nil || c.cut == nil {
+		return
+	}
+	if r := c.row(i); r < c.data {
+		c.cut[[2]int{r, j}] = true
+	}
+}
+
+// wrap is wrapRowsTo, also recording the row each line came from.
+func (c *layoutCuts) wrap(opts Options, rows [][]string, width func(col int) int) [][]string {
+	if c == nil || c.cut == nil {
+		return wrapRowsTo(opts, rows, width)
+	}
+	out := make([][]string, 0, len(rows))
+	var from []int
+	for i := range rows {
+		lines := wrapRowsTo(opts, rows[i:i+1], width)
+		out = append(out, lines...)
+		for range lines {
+			from = append(from, c.row(i))
+		}
+	}
+	c.from = from
+	return out
+}
+
+// cells records the cells of rows that applyCellOpts shortens.
+func (c *layoutCuts) cells(opts Options, rows [][]string) {
+	if c == nil || opts.MaxColumnWidth <= 0 {
+		return
+	}
+	for i, r := range rows {
+		for j, v := range r {
+			if v == "" {
+				v = opts.NullPlaceholder
+			}
+			if cell, _ := applyCellOpts(v, opts); cell != v {
+				c.cutAt(i, j)
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package tablewriter_test
+
+import (
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRenderStats(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{
+		Headers:        []string{"Name", "Bio"},
+		Format:         tablewriter.FormatCSV,
+		MaxColumnWidth: 8,
+	})
+	tbl.AddRows([][]string{
+		{"Alice", "Writes compilers"},
+		{"Bob", "Gardener"},
+		{"Christopher", "Pilot", "extra"},
+	})
+	out, stats, err := tbl.RenderStats()
+	if err != nil {
+		t.Fatalf("RenderStats() error = %v", err)
+	}
+	want := tablewriter.Stats{Rows: 3, Columns: 3, TruncatedCells: 2, Bytes: len(out)}
+	stats.Duration = 0
+	if stats != want {
+		t.Errorf("RenderStats() stats = %+v, want %+v", stats, want)
+	}
+}
+
+func TestRenderStatsTruncatedCells(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    tablewriter.Options
+		footers []string
+		want    int
+	}{
+		{"fits", tablewriter.Options{MaxColumnWidth: 20}, nil, 0},
+		{"max column width", tablewriter.Options{MaxColumnWidth: 6}, nil, 1},
+		{"display width", tablewriter.Options{MaxColumnWidth: 6, TruncateUnit: tablewriter.TruncateDisplayWidth}, nil, 2},
+		{"wrapped", tablewriter.Options{MaxColumnWidth: 6, WrapCells: true}, nil, 0},
+		{"table width", tablewriter.Options{MaxTableWidth: 16}, nil, 2},
+		{"wrapped to table width", tablewriter.Options{MaxColumnWidth: 8, WrapCells: true, MaxTableWidth: 14}, nil, 2},
+		{"footers not counted", tablewriter.Options{MaxColumnWidth: 6}, []string{"Total value", "3"}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			opts.Headers = []string{"Name", "Qty"}
+			tbl := tablewriter.New(opts)
+			tbl.AddRows([][]string{{"Tokyo Tower", "1"}, {"日本語の本", "2"}, {"Pen", "3"}})
+			if tt.footers != nil {
+				tbl.AddFooter(tt.footers...)
+			}
+			_, stats, err := tbl.RenderStats()
+			if err != nil {
+				t.Fatalf("RenderStats() error = %v", err)
+			}
+			if stats.TruncatedCells != tt.want {
+				t.Errorf("RenderStats() TruncatedCells = %d, want %d", stats.TruncatedCells, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package tablewriter
+
+import (
+	"sort"
+	"strings"
+)
+
+// StatusStyle describes how one status value is displayed.
+type StatusStyle struct {
+	// Symbol is shown before the value, e.g. "●". Empty means no symbol.
+	Symbol string
+
+	// Color is an ANSI SGR color code, e.g. "32" for green. Empty means no color.
+	Color string
+
+	// Class is the CSS class of the cell in FormatHTML output, e.g.
+	// "status-ok". Empty means no class.
+	Class string
+}
+
+// DefaultStatusStyles maps common health values to symbols, ANSI colors,
+// and CSS classes.
+var DefaultStatusStyles = map[string]StatusStyle{
+	"OK":      {Symbol: "●", Color: "32", Class: "status-ok"},
+	"WARN":    {Symbol: "▲", Color: "33", Class: "status-warn"},
+	"FAIL":    {Symbol: "✗", Color: "31", Class: "status-fail"},
+	"UNKNOWN": {Symbol: "?", Color: "90", Class: "status-unknown"},
+}
+
+// StatusFormatter returns a Formatter that puts the symbol from styles
+// before status values, matched case-insensitively. Unknown values are
+// left unchanged. Color the column with StatusRules.
+//
+// Example:
+//
+//	opts := tablewriter.Options{
+//	    Headers:    []string{"Service", "Health"},
+//	    Formatters: []tablewriter.Formatter{nil, tablewriter.StatusFormatter(tablewriter.DefaultStatusStyles)},
+//	    StyleRules: map[string][]tablewriter.StyleRule{"Health": tablewriter.StatusRules(tablewriter.DefaultStatusStyles)},
+//	}
+func StatusFormatter(styles map[string]StatusStyle) Formatter {
+	byKey := make(map[string]StatusStyle, len(styles))
+	for k, st := range styles {
+		byKey[strings.ToUpper(k)] = st
+	}
+	return func(v string) string {
+		st, ok := byKey[strings.ToUpper(strings.TrimSpace(v))]
+		if !ok || st.Symbol == "" {
+			return v
+		}
+		return st.Symbol + " " + v
+	}
+}
+
+// StatusRules returns StyleRules that give each status value in styles its
+// Color and Class, matched case-insensitively, for use in
+// Options.StyleRules. Like any style rule, the colors are left out with
+// NoColor and in machine-readable formats, and FormatHTML uses the classes.
+//
+// Example:
+//
+//	opts.StyleRules = map[string][]tablewriter.StyleRule{
+//	    "Health": tablewriter.StatusRules(tablewriter.DefaultStatusStyles),
+//	}
+func StatusRules(styles map[string]StatusStyle) []StyleRule {
+	names := make([]string, 0, len(styles))
+	for name, st := range styles {
+		if st.Color != "" || st.Class != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	rules := make([]StyleRule, len(names))
+	for i, name := range names {
+		st := styles[name]
+		rules[i] = StyleRule{When: name, Color: st.Color, Class: st.Class}
+	}
+	return rules
+}
@@ -0,0 +1,63 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestStatusFormatter(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"ok", "OK", "● OK"},
+		{"case insensitive", "warn", "▲ warn"},
+		{"unknown value", "PENDING", "PENDING"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := tablewriter.StatusFormatter(tablewriter.DefaultStatusStyles)
+			if got := f(tt.in); got != tt.want {
+				t.Errorf("StatusFormatter()(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  tablewriter.Format
+		noColor bool
+		want    string
+	}{
+		{"simple", tablewriter.FormatSimple, false, "\x1b[31m✗ FAIL\x1b[0m"},
+		{"no color", tablewriter.FormatSimple, true, "✗ FAIL \n"},
+		{"csv", tablewriter.FormatCSV, false, "Health\n✗ FAIL\n● ok\nPENDING\n"},
+		{"html", tablewriter.FormatHTML, false, `<tr><td class="status-fail">✗ FAIL</td></tr>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:    []string{"Health"},
+				Format:     tt.format,
+				NoColor:    tt.noColor,
+				Formatters: []tablewriter.Formatter{tablewriter.StatusFormatter(tablewriter.DefaultStatusStyles)},
+				StyleRules: map[string][]tablewriter.StyleRule{"Health": tablewriter.StatusRules(tablewriter.DefaultStatusStyles)},
+			}
+			out, err := tablewriter.Render(opts, [][]string{{"FAIL"}, {"ok"}, {"PENDING"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("Render() = %q, want it to contain %q", out, tt.want)
+			}
+			if (tt.noColor || tt.format != tablewriter.FormatSimple) && strings.Contains(out, "\x1b") {
+				t.Errorf("Render() = %q, want no escape sequences", out)
+			}
+		})
+	}
+}
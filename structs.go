@@ -0,0 +1,318 @@
+package tablewriter
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrNotStructSlice is returned when struct import is given something other
+// than a slice of structs or struct pointers.
+var ErrNotStructSlice = errors.New("tablewriter: value is not a slice of structs")
+
+// ErrNotStruct is returned when AddRowStruct is given something other than a
+// struct or a non-nil struct pointer.
+var ErrNotStruct = errors.New("tablewriter: value is not a struct")
+
+// ErrInvalidStructTag is returned when a `table` struct tag cannot be parsed.
+var ErrInvalidStructTag = errors.New("tablewriter: invalid table struct tag")
+
+// structColumn describes how one struct field becomes a table column.
+type structColumn struct {
+	index  []int
+	name   string
+	align  Alignment
+	width  int
+	format string
+	depth  int // levels of nested structs to flatten; -1 = unlimited
+}
+
+var (
+	stringerType      = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// FromStructs builds a Table from a slice of structs (or struct pointers),
+// one row per element and one column per exported field. Fields are
+// configured with a `table` struct tag:
+//
+//	type Item struct {
+//	    Name  string  `table:"Item Name"`
+//	    Price float64 `table:"Price,align=right,width=10,format=%.2f"`
+//	    SKU   string  `table:"-"` // omitted
+//	}
+//
+// The first tag element is the header (the field name if empty). Options are
+// align=left|center|right, width=N (per-column truncation, see
+// Options.MaxColumnWidths), format=VERB (an fmt verb for the value), and
+// depth=N (see below).
+// Headers, Alignments, and MaxColumnWidths in opts are replaced.
+//
+// Fields of embedded structs are promoted as if declared in the outer
+// struct. Nested struct fields are flattened into one column per inner
+// field, named "<outer> <inner>", e.g. "Address City"; depth=N on the outer
+// field limits flattening to N levels, and depth=0 keeps it a single
+// column. Types implementing fmt.Stringer or encoding.TextMarshaler, such
+// as time.Time, are never flattened.
+//
+// Example:
+//
+//	t, err := tablewriter.FromStructs(items, tablewriter.Options{Format: tablewriter.FormatMarkdown})
+func FromStructs(items any, opts Options) (*Table, error) {
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("%w: got %T", ErrNotStructSlice, items)
+	}
+	elem := v.Type().Elem()
+	if elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: got %T", ErrNotStructSlice, items)
+	}
+	cols, err := structColumns(elem)
+	if err != nil {
+		return nil, err
+	}
+
+	t := New(structOptions(opts, cols))
+	for i := 0; i < v.Len(); i++ {
+		if err := t.AddRow(structRow(v.Index(i), cols)...); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// AddRowStruct appends one struct (or struct pointer) as a row, matching
+// fields to the table's headers by their `table` tag name, or field name if
+// untagged, as FromStructs does. Headers without a matching field get an
+// empty cell, and fields without a matching header are ignored. If the table
+// has neither headers nor rows, the struct's columns become its headers,
+// alignments, and widths.
+// Returns ErrNotStruct for other values, and otherwise behaves like AddRow.
+//
+// Example:
+//
+//	for ev := range events {
+//	    if err := t.AddRowStruct(ev); err != nil {
+//	        return err
+//	    }
+//	}
+func (t *Table) AddRowStruct(v any) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return fmt.Errorf("%w: got nil %T", ErrNotStruct, v)
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: got %T", ErrNotStruct, v)
+	}
+	cols, err := structColumns(rv.Type())
+	if err != nil {
+		return err
+	}
+	headers := resolveColumns(t.opts).Headers
+	if len(headers) == 0 && len(t.rows) == 0 {
+		t.opts = structOptions(t.opts, cols)
+		t.widths.reset()
+		return t.AddRow(structRow(rv, cols)...)
+	}
+
+	byName := make(map[string]structColumn, len(cols))
+	for _, c := range cols {
+		byName[c.name] = c
+	}
+	row := make([]string, len(headers))
+	for i, h := range headers {
+		if c, ok := byName[h]; ok {
+			if fv, err := rv.FieldByIndexErr(c.index); err == nil {
+				row[i] = formatField(fv, c.format)
+			}
+		}
+	}
+	return t.AddRow(row...)
+}
+
+// structOptions returns opts with Headers, Alignments, and MaxColumnWidths
+// taken from cols.
+func structOptions(opts Options, cols []structColumn) Options {
+	opts.Headers = make([]string, len(cols))
+	opts.Alignments = make([]Alignment, len(cols))
+	opts.MaxColumnWidths = make([]int, len(cols))
+	for i, c := range cols {
+		opts.Headers[i] = c.name
+		opts.Alignments[i] = c.align
+		opts.MaxColumnWidths[i] = c.width
+	}
+	return opts
+}
+
+// structColumns derives the columns for struct type typ from its exported
+// fields and their `table` tags.
+func structColumns(typ reflect.Type) ([]structColumn, error) {
+	return appendStructColumns(nil, typ, nil, "", -1, map[reflect.Type]bool{typ: true})
+}
+
+// appendStructColumns appends the columns of struct type typ, whose value
+// is reached from the outermost struct by index, to cols. Header names get
+// prefix, and nested structs are flattened up to depth levels (-1 =
+// unlimited). seen holds the struct types being expanded, to stop on
+// recursive types.
+func appendStructColumns(cols []structColumn, typ reflect.Type, index []int, prefix string, depth int, seen map[reflect.Type]bool) ([]structColumn, error) {
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+		tag, ok := f.Tag.Lookup("table")
+		if tag == "-" {
+			continue
+		}
+		col := structColumn{index: append(append([]int(nil), index...), i), name: f.Name, depth: -1}
+		if ok {
+			if err := parseStructTag(tag, &col); err != nil {
+				return nil, fmt.Errorf("%w: field %s: %v", ErrInvalidStructTag, f.Name, err)
+			}
+		}
+		inner, flatten := flattenType(f.Type, seen)
+		promoted := f.Anonymous && (!ok || strings.Split(tag, ",")[0] == "")
+		if flatten && (depth != 0 || promoted) && col.depth != 0 {
+			// d is how many more levels the inner struct may flatten.
+			// Promoting embedded fields does not count as a level.
+			name, d := prefix+col.name+" ", depth-1
+			if promoted {
+				name, d = prefix, depth
+			}
+			if depth < 0 {
+				d = -1
+			}
+			if col.depth > 0 && (d < 0 || col.depth-1 < d) {
+				d = col.depth - 1
+			}
+			var err error
+			seen[inner] = true
+			cols, err = appendStructColumns(cols, inner, col.index, name, d, seen)
+			delete(seen, inner)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue // unexported embedded non-struct
+		}
+		col.name = prefix + col.name
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+// flattenType returns the struct type t refers to and whether its fields
+// become separate columns: t must be a struct or pointer to one with
+// exported fields, must not format itself via fmt.Stringer or
+// encoding.TextMarshaler, and must not already be in seen.
+func flattenType(t reflect.Type, seen map[reflect.Type]bool) (reflect.Type, bool) {
+	for _, impl := range []reflect.Type{stringerType, textMarshalerType} {
+		if t.Implements(impl) || reflect.PointerTo(t).Implements(impl) {
+			return nil, false
+		}
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return nil, false
+	}
+	for _, impl := range []reflect.Type{stringerType, textMarshalerType} {
+		if reflect.PointerTo(t).Implements(impl) {
+			return nil, false
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.IsExported() || f.Anonymous {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// parseStructTag applies a `table:"name,key=value,..."` tag to col.
+func parseStructTag(tag string, col *structColumn) error {
+	parts := strings.Split(tag, ",")
+	if parts[0] != "" {
+		col.name = parts[0]
+	}
+	for _, p := range parts[1:] {
+		key, val, _ := strings.Cut(strings.TrimSpace(p), "=")
+		switch key {
+		case "align":
+			switch val {
+			case "left":
+				col.align = AlignLeft
+			case "center":
+				col.align = AlignCenter
+			case "right":
+				col.align = AlignRight
+			default:
+				return fmt.Errorf("unknown alignment %q", val)
+			}
+		case "width":
+			w, err := strconv.Atoi(val)
+			if err != nil || w < 0 {
+				return fmt.Errorf("invalid width %q", val)
+			}
+			col.width = w
+		case "format":
+			col.format = val
+		case "depth":
+			d, err := strconv.Atoi(val)
+			if err != nil || d < 0 {
+				return fmt.Errorf("invalid depth %q", val)
+			}
+			col.depth = d
+		default:
+			return fmt.Errorf("unknown option %q", key)
+		}
+	}
+	return nil
+}
+
+// structRow formats the fields of struct value v as a row.
+func structRow(v reflect.Value, cols []structColumn) []string {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return make([]string, len(cols))
+		}
+		v = v.Elem()
+	}
+	row := make([]string, len(cols))
+	for i, c := range cols {
+		fv, err := v.FieldByIndexErr(c.index)
+		if err != nil {
+			continue
+		}
+		row[i] = formatField(fv, c.format)
+	}
+	return row
+}
+
+// formatField converts a field value to cell text, applying the fmt verb
+// format if given. Nil pointers and interfaces become empty cells.
+func formatField(v reflect.Value, format string) string {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if format != "" {
+		return fmt.Sprintf(format, v.Interface())
+	}
+	return formatSQLValue(v.Interface())
+}
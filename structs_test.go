@@ -0,0 +1,144 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+type item struct {
+	Name  string  `table:"Item Name"`
+	Price float64 `table:"Price,align=right,format=%.2f"`
+	Notes string  `table:",width=8"`
+	SKU   string  `table:"-"`
+	Stock *int
+	cost  float64
+}
+
+func TestFromStructs(t *testing.T) {
+	stock := 4
+	items := []*item{
+		{Name: "Widget", Price: 2.5, Notes: "best seller in EMEA", SKU: "W-1", Stock: &stock, cost: 1},
+		{Name: "Gadget", Price: 10, Notes: "new"},
+	}
+	tbl, err := tablewriter.FromStructs(items, tablewriter.Options{Format: tablewriter.FormatCSV})
+	if err != nil {
+		t.Fatalf("FromStructs() error = %v", err)
+	}
+	if got, want := tbl.Headers(), []string{"Item Name", "Price", "Notes", "Stock"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Headers() = %q, want %q", got, want)
+	}
+	if got, want := tbl.Rows(), [][]string{{"Widget", "2.50", "best seller in EMEA", "4"}, {"Gadget", "10.00", "new", ""}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Rows() = %q, want %q", got, want)
+	}
+	out, err := tbl.RenderErr()
+	if err != nil {
+		t.Fatalf("RenderErr() error = %v", err)
+	}
+	if want := "Item Name,Price,Notes,Stock\nWidget,2.50,best ...,4\nGadget,10.00,new,\n"; out != want {
+		t.Errorf("RenderErr() = %q, want %q", out, want)
+	}
+}
+
+func TestFromStructsErrors(t *testing.T) {
+	type badTag struct {
+		A string `table:"A,align=diagonal"`
+	}
+	tests := []struct {
+		name    string
+		items   any
+		wantErr error
+	}{
+		{"not a slice", item{}, tablewriter.ErrNotStructSlice},
+		{"slice of ints", []int{1}, tablewriter.ErrNotStructSlice},
+		{"bad tag", []badTag{{}}, tablewriter.ErrInvalidStructTag},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tablewriter.FromStructs(tt.items, tablewriter.Options{}); !errors.Is(err, tt.wantErr) {
+				t.Errorf("FromStructs() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAddRowStruct(t *testing.T) {
+	stock := 4
+	tbl := tablewriter.New(tablewriter.Options{Format: tablewriter.FormatCSV})
+	if err := tbl.AddRowStruct(&item{Name: "Widget", Price: 2.5, Stock: &stock}); err != nil {
+		t.Fatalf("AddRowStruct() error = %v", err)
+	}
+	if got, want := tbl.Headers(), []string{"Item Name", "Price", "Notes", "Stock"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Headers() = %q, want %q", got, want)
+	}
+
+	other := tablewriter.New(tablewriter.Options{Headers: []string{"Stock", "Missing", "Item Name"}})
+	if err := other.AddRowStruct(item{Name: "Gadget", Stock: &stock}); err != nil {
+		t.Fatalf("AddRowStruct() error = %v", err)
+	}
+	if got, want := other.Rows(), [][]string{{"4", "", "Gadget"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Rows() = %q, want %q", got, want)
+	}
+
+	for _, v := range []any{42, (*item)(nil), []item{}} {
+		if err := other.AddRowStruct(v); !errors.Is(err, tablewriter.ErrNotStruct) {
+			t.Errorf("AddRowStruct(%T) error = %v, want %v", v, err, tablewriter.ErrNotStruct)
+		}
+	}
+}
+
+type address struct {
+	City string
+	Geo  struct{ Lat, Lng float64 }
+}
+
+type audit struct {
+	CreatedBy string `table:"Created By"`
+}
+
+type customer struct {
+	Name string
+	audit
+	Address address
+	Billing *address `table:"Bill,depth=1"`
+	Home    address  `table:",depth=0"`
+	Since   time.Time
+}
+
+func TestFromStructsFlatten(t *testing.T) {
+	since := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	c := customer{Name: "ACME", audit: audit{CreatedBy: "bob"}, Since: since}
+	c.Address.City = "Oslo"
+	c.Address.Geo.Lat = 59.9
+	tbl, err := tablewriter.FromStructs([]customer{c}, tablewriter.Options{})
+	if err != nil {
+		t.Fatalf("FromStructs() error = %v", err)
+	}
+	wantHeaders := []string{"Name", "Created By", "Address City", "Address Geo Lat", "Address Geo Lng", "Bill City", "Bill Geo", "Home", "Since"}
+	if got := tbl.Headers(); !reflect.DeepEqual(got, wantHeaders) {
+		t.Errorf("Headers() = %q, want %q", got, wantHeaders)
+	}
+	row := tbl.Rows()[0]
+	wantRow := []string{"ACME", "bob", "Oslo", "59.9", "0", "", "", "{ {0 0}}", "2024-01-02T00:00:00Z"}
+	if !reflect.DeepEqual(row, wantRow) {
+		t.Errorf("Rows()[0] = %q, want %q", row, wantRow)
+	}
+}
+
+type linkedNode struct {
+	Name string
+	Next *linkedNode
+}
+
+func TestFromStructsRecursive(t *testing.T) {
+	tbl, err := tablewriter.FromStructs([]linkedNode{{Name: "a"}}, tablewriter.Options{})
+	if err != nil {
+		t.Fatalf("FromStructs() error = %v", err)
+	}
+	if got, want := tbl.Headers(), []string{"Name", "Next"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Headers() = %q, want %q", got, want)
+	}
+}
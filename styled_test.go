@@ -0,0 +1,34 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestStyledCellTruncation(t *testing.T) {
+	red := "\x1b[31m"
+	reset := "\x1b[0m"
+	tests := []struct {
+		name string
+		cell string
+		want string
+	}{
+		{"fits", red + "short" + reset, red + "short" + reset},
+		{"truncated", red + "critical failure" + reset, red + "cri..." + reset},
+		{"plain", "critical failure", "cri..."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Format: tablewriter.FormatCSV, MaxColumnWidth: 6}
+			out, err := tablewriter.Render(opts, [][]string{{tt.cell}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got := strings.TrimSuffix(out, "\n"); got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package tablewriter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidStyleRule is returned when a StyleRule's condition or color
+// cannot be parsed.
+var ErrInvalidStyleRule = errors.New("tablewriter: invalid style rule")
+
+// StyleRule colors the cells of a column that meet a condition. Rules are
+// plain data, so they can be loaded from configuration files.
+type StyleRule struct {
+	// When is the condition. A comparison such as ">= 90", "< 0", or
+	// "!= 0" compares numerically; "==" and "!=" with a non-numeric
+	// operand compare text, ignoring case. Anything else is a list of
+	// values separated by "|", such as "warn|fail", matched ignoring case
+	// and surrounding space.
+	When string
+
+	// Color is an ANSI SGR code such as "31" or "1;33", or one of the
+	// names black, red, green, yellow, blue, magenta, cyan, white, gray,
+	// bold, dim, underline, and reverse. It may be empty if Class is set.
+	Color string
+
+	// Class is the CSS class given to matching cells in FormatHTML output,
+	// which never carries ANSI codes. Empty means no class.
+	Class string
+}
+
+// styleColors maps the color names a StyleRule accepts to SGR codes.
+var styleColors = map[string]string{
+	"black": "30", "red": "31", "green": "32", "yellow": "33", "blue": "34",
+	"magenta": "35", "cyan": "36", "white": "37", "gray": "90", "grey": "90",
+	"bold": "1", "dim": "2", "underline": "4", "reverse": "7",
+}
+
+// styleOps are the comparison operators a StyleRule condition may start
+// with, longest first so ">=" is not read as ">".
+var styleOps = []string{">=", "<=", "==", "!=", ">", "<", "="}
+
+// styleRule is a parsed StyleRule.
+type styleRule struct {
+	op      string   // comparison operator, or "" for a value list
+	operand string   // right-hand side of the comparison
+	num     float64  // operand as a number
+	numeric bool     // operand parses as a number
+	values  []string // lower-cased values for a value list
+	sgr     string   // SGR code to apply, or ""
+	class   string   // CSS class to apply in FormatHTML, or ""
+}
+
+// cellStyle is the styling a rule assigns to one cell.
+type cellStyle struct {
+	sgr   string
+	class string
+}
+
+// parseStyleRule parses r.
+func parseStyleRule(r StyleRule) (styleRule, error) {
+	var p styleRule
+	p.sgr = strings.TrimSpace(r.Color)
+	p.class = strings.TrimSpace(r.Class)
+	if code, ok := styleColors[strings.ToLower(p.sgr)]; ok {
+		p.sgr = code
+	} else if (p.sgr == "" && p.class == "") || strings.Trim(p.sgr, "0123456789;") != "" {
+		return p, fmt.Errorf("%w: unknown color %q", ErrInvalidStyleRule, r.Color)
+	}
+	when := strings.TrimSpace(r.When)
+	for _, op := range styleOps {
+		if rest, ok := strings.CutPrefix(when, op); ok {
+			p.op = op
+			if op == "=" {
+				p.op = "=="
+			}
+			p.operand = strings.TrimSpace(rest)
+			n, err := strconv.ParseFloat(p.operand, 64)
+			p.num, p.numeric = n, err == nil
+			if !p.numeric && p.op != "==" && p.op != "!=" {
+				return p, fmt.Errorf("%w: %q compares with a non-number", ErrInvalidStyleRule, r.When)
+			}
+			return p, nil
+		}
+	}
+	if when == "" {
+		return p, fmt.Errorf("%w: empty condition", ErrInvalidStyleRule)
+	}
+	for _, v := range strings.Split(when, "|") {
+		p.values = append(p.values, strings.ToLower(strings.TrimSpace(v)))
+	}
+	return p, nil
+}
+
+// matches reports whether cell v meets the rule's condition.
+func (p styleRule) matches(v string) bool {
+	v = strings.TrimSpace(v)
+	if p.op == "" {
+		for _, want := range p.values {
+			if strings.ToLower(v) == want {
+				return true
+			}
+		}
+		return false
+	}
+	n, err := strconv.ParseFloat(v, 64)
+	if !p.numeric || err != nil {
+		equal := strings.EqualFold(v, p.operand)
+		switch p.op {
+		case "==":
+			return equal
+		case "!=":
+			return !equal
+		default:
+			return false
+		}
+	}
+	switch p.op {
+	case ">=":
+		return n >= p.num
+	case "<=":
+		return n <= p.num
+	case ">":
+		return n > p.num
+	case "<":
+		return n < p.num
+	case "==":
+		return n == p.num
+	default:
+		return n != p.num
+	}
+}
+
+// matchStyleRules returns, for each cell of rows, the style of the first
+// rule in opts.StyleRules that its column's raw value meets, or the zero
+// cellStyle. Empty cells are never styled, so null placeholders still apply
+// to them.
+func matchStyleRules(opts Options, rows [][]string) ([][]cellStyle, error) {
+	rules := map[int][]styleRule{}
+	for name, rs := range opts.StyleRules {
+		col := indexOf(opts.Headers, name)
+		if col < 0 {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownColumn, name)
+		}
+		for _, r := range rs {
+			p, err := parseStyleRule(r)
+			if err != nil {
+				return nil, fmt.Errorf("column %q: %w", name, err)
+			}
+			rules[col] = append(rules[col], p)
+		}
+	}
+	styles := make([][]cellStyle, len(rows))
+	for i, r := range rows {
+		for col, rs := range rules {
+			v := cellAt(r, col)
+			if v == "" || v == Null {
+				continue
+			}
+			for _, p := range rs {
+				if p.matches(v) {
+					if styles[i] == nil {
+						styles[i] = make([]cellStyle, len(r))
+					}
+					styles[i][col] = cellStyle{p.sgr, p.class}
+					break
+				}
+			}
+		}
+	}
+	return styles, nil
+}
+
+// applyStyles wraps every cell with a style code in its ANSI sequence.
+func applyStyles(rows [][]string, styles [][]cellStyle) {
+	for i, cells := range styles {
+		for col, st := range cells {
+			if st.sgr != "" {
+				rows[i][col] = "\x1b[" + st.sgr + "m" + rows[i][col] + ansiReset
+			}
+		}
+	}
+}
+
+// styleClasses returns the CSS class of every cell in styles, or nil if no
+// cell has one.
+func styleClasses(styles [][]cellStyle) [][]string {
+	var classes [][]string
+	for i, cells := range styles {
+		for col, st := range cells {
+			if st.class == "" {
+				continue
+			}
+			if classes == nil {
+				classes = make([][]string, len(styles))
+			}
+			if classes[i] == nil {
+				classes[i] = make([]string, len(cells))
+			}
+			classes[i][col] = st.class
+		}
+	}
+	return classes
+}
+
+// keepsColor reports whether format f is meant for a terminal, so that
+// StyleRules color it with ANSI codes.
+func keepsColor(f Format) bool {
+	return isTextFormat(f) || f == FormatList
+}
+
+// WithStyleRules returns a copy of Options that colors cells of column as
+// rules describe, replacing any rules the column already had. Returns
+// ErrInvalidStyleRule if a rule cannot be parsed.
+//
+// Example:
+//
+//	opts, err := tablewriter.DefaultOptions().WithStyleRules("CPU %",
+//	    tablewriter.StyleRule{When: ">= 90", Color: "red"},
+//	    tablewriter.StyleRule{When: ">= 70", Color: "yellow"},
+//	)
+func (o Options) WithStyleRules(column string, rules ...StyleRule) (Options, error) {
+	for _, r := range rules {
+		if _, err := parseStyleRule(r); err != nil {
+			return o, fmt.Errorf("column %q: %w", column, err)
+		}
+	}
+	m := make(map[string][]StyleRule, len(o.StyleRules)+1)
+	for k, v := range o.StyleRules {
+		m[k] = v
+	}
+	m[column] = rules
+	o.StyleRules = m
+	return o, nil
+}
@@ -0,0 +1,113 @@
+package tablewriter_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestStyleRules(t *testing.T) {
+	var rules map[string][]tablewriter.StyleRule
+	config := `{
+		"Score":  [{"When": ">= 90", "Color": "red"}, {"When": "< 50", "Color": "1;34"}],
+		"Status": [{"When": "warn|fail", "Color": "yellow"}, {"When": "!= ok", "Color": "gray"}]
+	}`
+	if err := json.Unmarshal([]byte(config), &rules); err != nil {
+		t.Fatal(err)
+	}
+	opts := tablewriter.Options{
+		Format:     tablewriter.FormatList,
+		Headers:    []string{"Host", "Score", "Status"},
+		StyleRules: rules,
+		Formatters: []tablewriter.Formatter{nil, tablewriter.NumberFormatter(1, tablewriter.NotationFixed)},
+	}
+	rows := [][]string{
+		{"a", "95", "OK"},
+		{"b", "70", "Fail"},
+		{"c", "12", "unknown"},
+		{"d", "n/a", ""},
+	}
+	out, err := tablewriter.Render(opts, rows)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	want := "Host: a; Score: \x1b[31m95.0\x1b[0m; Status: OK\n" +
+		"Host: b; Score: 70.0; Status: \x1b[33mFail\x1b[0m\n" +
+		"Host: c; Score: \x1b[1;34m12.0\x1b[0m; Status: \x1b[90munknown\x1b[0m\n" +
+		"Host: d; Score: n/a; Status: \n"
+	if out != want {
+		t.Errorf("Render() = %q, want %q", out, want)
+	}
+
+	opts.NoColor = true
+	if out, err := tablewriter.Render(opts, rows); err != nil || strings.Contains(out, "\x1b") {
+		t.Errorf("Render() with NoColor = %q, %v, want no escape codes", out, err)
+	}
+	opts.NoColor = false
+	opts.Format = tablewriter.FormatCSV
+	if out, err := tablewriter.Render(opts, rows); err != nil || strings.Contains(out, "\x1b") {
+		t.Errorf("Render() as CSV = %q, %v, want no escape codes", out, err)
+	}
+}
+
+func TestStyleRulesErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules map[string][]tablewriter.StyleRule
+		want  error
+	}{
+		{"unknown column", map[string][]tablewriter.StyleRule{"Nope": {{When: "> 1", Color: "red"}}}, tablewriter.ErrUnknownColumn},
+		{"unknown color", map[string][]tablewriter.StyleRule{"Score": {{When: "> 1", Color: "plaid"}}}, tablewriter.ErrInvalidStyleRule},
+		{"non-numeric comparison", map[string][]tablewriter.StyleRule{"Score": {{When: "> high", Color: "red"}}}, tablewriter.ErrInvalidStyleRule},
+		{"empty condition", map[string][]tablewriter.StyleRule{"Score": {{Color: "red"}}}, tablewriter.ErrInvalidStyleRule},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Format: tablewriter.FormatCSV, Headers: []string{"Score"}, StyleRules: tt.rules}
+			if _, err := tablewriter.Render(opts, [][]string{{"1"}}); !errors.Is(err, tt.want) {
+				t.Errorf("Render() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestStyleRulesHTMLClass(t *testing.T) {
+	opts := tablewriter.Options{
+		Format:           tablewriter.FormatHTML,
+		Headers:          []string{"Note", "Score"},
+		OmitEmptyColumns: true,
+		StyleRules:       map[string][]tablewriter.StyleRule{"Score": {{When: ">= 90", Color: "red", Class: "high"}}},
+	}
+	out, err := tablewriter.Render(opts, [][]string{{"", "95"}, {"", "12"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	for _, want := range []string{`<tr><td class="high">95</td></tr>`, `<tr><td>12</td></tr>`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Render() = %s, want row %s", out, want)
+		}
+	}
+	if strings.Contains(out, "\x1b") {
+		t.Errorf("Render() = %q, want no escape codes", out)
+	}
+}
+
+func TestWithStyleRules(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithStyleRules("CPU", tablewriter.StyleRule{When: "> high", Color: "red"}); !errors.Is(err, tablewriter.ErrInvalidStyleRule) {
+		t.Errorf("WithStyleRules(bad rule) error = %v, want %v", err, tablewriter.ErrInvalidStyleRule)
+	}
+	base, err := tablewriter.DefaultOptions().WithStyleRules("CPU", tablewriter.StyleRule{When: ">= 90", Color: "red"})
+	if err != nil {
+		t.Fatalf("WithStyleRules() error = %v", err)
+	}
+	opts, err := base.WithStyleRules("Status", tablewriter.StyleRule{When: "fail", Color: "red"})
+	if err != nil {
+		t.Fatalf("WithStyleRules() error = %v", err)
+	}
+	if len(base.StyleRules) != 1 || len(opts.StyleRules) != 2 {
+		t.Errorf("StyleRules = %v then %v, want 1 then 2 columns", base.StyleRules, opts.StyleRules)
+	}
+}
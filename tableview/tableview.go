@@ -0,0 +1,212 @@
+// Package tableview adapts a tablewriter.Table to interactive terminal UIs.
+// A Viewport renders the table once, then shows a scrollable window of it
+// sized to the widget, so TUI frameworks such as Bubble Tea and tcell need no
+// layout code of their own. The package has no dependencies beyond
+// tablewriter; it plugs into either framework through plain strings and a
+// cell-setting callback.
+//
+// # Bubble Tea
+//
+//	func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+//	    switch msg := msg.(type) {
+//	    case tea.WindowSizeMsg:
+//	        m.view.SetSize(msg.Width, msg.Height)
+//	    case tea.KeyMsg:
+//	        switch msg.String() {
+//	        case "down":
+//	            m.view.ScrollBy(0, 1)
+//	        case "pgdown":
+//	            m.view.PageDown()
+//	        }
+//	    }
+//	    return m, nil
+//	}
+//
+//	func (m model) View() string { return m.view.View() }
+//
+// # tcell
+//
+//	w, h := screen.Size()
+//	view.SetSize(w, h)
+//	view.Draw(func(x, y int, r rune) { screen.SetContent(x, y, r, nil, style) })
+package tableview
+
+import (
+	"strings"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+// Viewport is a scrollable, resizable window onto a rendered table.
+// It is not safe for concurrent use.
+type Viewport struct {
+	// Sticky is the number of lines at the top of the table, such as the
+	// header and its borders, that stay visible while scrolling vertically.
+	Sticky int
+
+	table         *tablewriter.Table
+	grid          [][]rune
+	width, height int
+	x, y          int
+}
+
+// New creates a Viewport over t and renders it. Call SetSize before
+// displaying it, and Refresh after changing the table.
+//
+// Example:
+//
+//	view, err := tableview.New(t)
+//	view.Sticky = 3 // top border, header, separator of FormatPlain
+func New(t *tablewriter.Table) (*Viewport, error) {
+	v := &Viewport{table: t}
+	return v, v.Refresh()
+}
+
+// Refresh re-renders the table, keeping the scroll position where possible.
+//
+// Example:
+//
+//	t.AddRow("web-3", "healthy")
+//	err := view.Refresh()
+func (v *Viewport) Refresh() error {
+	grid, err := v.table.RenderGrid()
+	if err != nil {
+		return err
+	}
+	v.grid = grid
+	v.clamp()
+	return nil
+}
+
+// SetSize sets the size of the widget in terminal cells.
+//
+// Example:
+//
+//	view.SetSize(80, 24)
+func (v *Viewport) SetSize(width, height int) {
+	v.width, v.height = max(width, 0), max(height, 0)
+	v.clamp()
+}
+
+// ScrollBy moves the window dx columns right and dy lines down; negative
+// values move left and up. The window stops at the table's edges.
+//
+// Example:
+//
+//	view.ScrollBy(0, 1)
+func (v *Viewport) ScrollBy(dx, dy int) {
+	v.ScrollTo(v.x+dx, v.y+dy)
+}
+
+// ScrollTo moves the window so that column x and scrollable line y are at
+// its top-left corner.
+//
+// Example:
+//
+//	view.ScrollTo(0, 0)
+func (v *Viewport) ScrollTo(x, y int) {
+	v.x, v.y = x, y
+	v.clamp()
+}
+
+// PageDown scrolls down by one window height.
+func (v *Viewport) PageDown() {
+	v.ScrollBy(0, v.bodyHeight())
+}
+
+// PageUp scrolls up by one window height.
+func (v *Viewport) PageUp() {
+	v.ScrollBy(0, -v.bodyHeight())
+}
+
+// Offset returns the current horizontal and vertical scroll position.
+func (v *Viewport) Offset() (x, y int) {
+	return v.x, v.y
+}
+
+// Lines returns the visible lines, each exactly as wide as the widget and
+// at most as many as its height.
+//
+// Example:
+//
+//	for _, line := range view.Lines() {
+//	    fmt.Println(line)
+//	}
+func (v *Viewport) Lines() []string {
+	if v.width == 0 || v.height == 0 {
+		return nil
+	}
+	var rows [][]rune
+	sticky := min(v.Sticky, len(v.grid), v.height)
+	rows = append(rows, v.grid[:sticky]...)
+	start := sticky + v.y
+	end := min(start+v.height-sticky, len(v.grid))
+	if start < end {
+		rows = append(rows, v.grid[start:end]...)
+	}
+	lines := make([]string, len(rows))
+	for i, r := range rows {
+		lines[i] = v.clip(r)
+	}
+	return lines
+}
+
+// View returns the visible lines joined by newlines, ready to be returned
+// from a Bubble Tea model's View method.
+func (v *Viewport) View() string {
+	return strings.Join(v.Lines(), "\n")
+}
+
+// Draw calls set for every cell of the widget, with coordinates relative to
+// its top-left corner, for frameworks such as tcell that paint cell by cell.
+// Cells beyond the end of the table are drawn as spaces.
+//
+// Example:
+//
+//	view.Draw(func(x, y int, r rune) { screen.SetContent(left+x, top+y, r, nil, style) })
+func (v *Viewport) Draw(set func(x, y int, r rune)) {
+	lines := v.Lines()
+	for y := 0; y < v.height; y++ {
+		var line []rune
+		if y < len(lines) {
+			line = []rune(lines[y])
+		}
+		for x := 0; x < v.width; x++ {
+			r := ' '
+			if x < len(line) {
+				r = line[x]
+			}
+			set(x, y, r)
+		}
+	}
+}
+
+// clip returns the part of row visible at the current horizontal offset,
+// padded to the widget width.
+func (v *Viewport) clip(row []rune) string {
+	out := make([]rune, v.width)
+	for i := range out {
+		out[i] = ' '
+		if j := v.x + i; j < len(row) {
+			out[i] = row[j]
+		}
+	}
+	return string(out)
+}
+
+// bodyHeight returns the number of scrollable lines shown at once.
+func (v *Viewport) bodyHeight() int {
+	return max(v.height-min(v.Sticky, len(v.grid)), 1)
+}
+
+// clamp keeps the scroll position within the table.
+func (v *Viewport) clamp() {
+	gridWidth := 0
+	if len(v.grid) > 0 {
+		gridWidth = len(v.grid[0])
+	}
+	sticky := min(v.Sticky, len(v.grid))
+	maxY := max(len(v.grid)-sticky-(v.height-min(sticky, v.height)), 0)
+	v.x = min(max(v.x, 0), max(gridWidth-v.width, 0))
+	v.y = min(max(v.y, 0), maxY)
+}
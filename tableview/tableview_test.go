@@ -0,0 +1,61 @@
+package tableview_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+	"github.com/njchilds90/go-tablewriter/tableview"
+)
+
+func newView(t *testing.T) *tableview.Viewport {
+	t.Helper()
+	tbl := tablewriter.New(tablewriter.Options{Format: tablewriter.FormatCSV, Headers: []string{"ID", "Name"}})
+	for i := 1; i <= 5; i++ {
+		tbl.AddRow(strconv.Itoa(i), "host-"+strconv.Itoa(i))
+	}
+	v, err := tableview.New(tbl)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return v
+}
+
+func TestViewportScroll(t *testing.T) {
+	v := newView(t)
+	v.Sticky = 1
+	v.SetSize(6, 3)
+
+	want := []string{"ID,Nam", "1,host", "2,host"}
+	if got := v.Lines(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() = %q, want %q", got, want)
+	}
+
+	v.ScrollBy(2, 100)
+	want = []string{",Name ", "host-4", "host-5"}
+	if got := v.Lines(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() after scrolling past the end = %q, want %q", got, want)
+	}
+	if x, y := v.Offset(); x != 2 || y != 3 {
+		t.Errorf("Offset() = %d, %d, want 2, 3", x, y)
+	}
+
+	v.PageUp()
+	if _, y := v.Offset(); y != 1 {
+		t.Errorf("Offset() after PageUp = %d, want 1", y)
+	}
+}
+
+func TestViewportDraw(t *testing.T) {
+	v := newView(t)
+	v.SetSize(3, 8)
+	cells := map[[2]int]rune{}
+	v.Draw(func(x, y int, r rune) { cells[[2]int{x, y}] = r })
+	if len(cells) != 24 {
+		t.Errorf("Draw() set %d cells, want 24", len(cells))
+	}
+	if cells[[2]int{0, 1}] != '1' || cells[[2]int{0, 7}] != ' ' {
+		t.Errorf("Draw() cells = %q", cells)
+	}
+}
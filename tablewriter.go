@@ -11,6 +11,15 @@
 //	t.AddRow("Alice", "30", "NYC")
 //	t.AddRow("Bob",   "25", "LA")
 //	fmt.Println(t.Render())
+//
+// # Styled Cells
+//
+// Cells may already contain ANSI SGR escape sequences, such as strings styled
+// with lipgloss or fatih/color. Layout measures only their visible text, and
+// truncation by MaxColumnWidth, MaxColumnWidths, or MaxTableWidth never cuts
+// an escape sequence; a truncated styled cell ends with a reset sequence so
+// its style cannot bleed into the borders. Set NoColor to strip styling
+// instead, or WidthFunc to measure text some other way.
 package tablewriter
 
 import "errors"
@@ -29,6 +38,11 @@ const (
 	FormatJSON
 	// FormatSimple renders a minimal table with no borders, only header separator.
 	FormatSimple
+	// FormatList renders each row as one "Name: Alice; Age: 30" line, which
+	// suits screen readers and grep better than a visual grid.
+	FormatList
+	// FormatHTML renders an HTML <table> for embedding in a page.
+	FormatHTML
 )
 
 // Alignment controls column text alignment.
@@ -40,37 +54,475 @@ const (
 	AlignRight                   // AlignRight aligns text to the right.
 )
 
+// DuplicateHeaderMode controls how FormatJSON handles repeated header names.
+type DuplicateHeaderMode int
+
+const (
+	DuplicateSuffix DuplicateHeaderMode = iota // DuplicateSuffix renames repeats to "name_2", "name_3", ... (default).
+	DuplicateError                             // DuplicateError fails rendering with ErrDuplicateHeader.
+)
+
 // ErrMissingHeaders is returned when FormatJSON is used without headers.
 var ErrMissingHeaders = errors.New("tablewriter: JSON format requires headers")
 
+// ErrDuplicateHeader is returned when FormatJSON is used with repeated header
+// names and DuplicateHeaders is DuplicateError.
+var ErrDuplicateHeader = errors.New("tablewriter: duplicate header name")
+
+// ErrRowOutOfRange is returned when a row index is outside the table.
+var ErrRowOutOfRange = errors.New("tablewriter: row index out of range")
+
 // ErrColumnMismatch is returned when a row has a different number of columns than expected.
 var ErrColumnMismatch = errors.New("tablewriter: row column count does not match header count")
 
 // Options configures table rendering behavior.
+//
+// Options can be stored as JSON, with Format and Alignment values encoded by
+// name. Function-valued fields such as Formatters and WidthFunc are not
+// serialized and must be set in code.
 type Options struct {
+	// Columns is the per-column schema. When set, it replaces Headers,
+	// Alignments, MaxColumnWidths, Formatters, NullPlaceholders,
+	// ColumnPriorities, and CollapseRepeats.
+	Columns []Column
+
 	// Headers is the list of column names. Optional except for FormatJSON.
 	Headers []string
 
+	// HeaderLabels maps header keys to display labels, e.g. "created_at" to
+	// "Created". Labels are shown in every format except FormatJSON, which
+	// keeps the keys; options that name columns always use the keys.
+	HeaderLabels map[string]string
+
+	// HeaderCase converts header keys without a HeaderLabels entry for
+	// display, e.g. TitleCase. Like labels, it does not affect FormatJSON.
+	HeaderCase func(string) string `json:"-"`
+
+	// Units sets a per-column unit, e.g. "ms" or "GiB", shown in a second
+	// header row beneath the headers in FormatPlain and FormatSimple and
+	// after a "<br>" in FormatMarkdown headers. With ResponsiveWidth or
+	// SplitWidth, units follow the header in parentheses instead. Other
+	// formats ignore them.
+	Units []string
+
+	// NoHeader omits the header row from FormatPlain, FormatSimple,
+	// FormatCSV, and FormatHTML output, e.g. to pipe raw values into cut or
+	// awk or to concatenate several exports. Headers still name columns for
+	// other options. FormatMarkdown tables require a header row and
+	// FormatJSON and FormatList use headers as keys, so they ignore it.
+	NoHeader bool
+
 	// Format controls the output format. Defaults to FormatPlain.
 	Format Format
 
 	// Alignments sets per-column alignment. If shorter than column count, AlignLeft is used.
 	Alignments []Alignment
 
+	// ColumnTypes declares per-column data types. Typed columns sort by value
+	// when compared with SortLexical, Int and Float columns default to
+	// AlignRight, and FormatJSON encodes Int, Float, and Bool cells as JSON
+	// numbers and booleans (empty cells as null). Missing entries are
+	// TypeString.
+	ColumnTypes []ColumnType
+
 	// MaxColumnWidth truncates cell values longer than this. 0 = no limit.
 	MaxColumnWidth int
 
+	// WidthFunc measures the display width of cell and header text for column
+	// layout. Defaults to counting runes, ignoring ANSI escape sequences.
+	WidthFunc func(string) int `json:"-"`
+
+	// WrapCells wraps cell text longer than MaxColumnWidth onto several lines
+	// in text formats instead of truncating it. Lines break at spaces where
+	// possible; words longer than the column are split. In FormatPlain and
+	// FormatSimple each extra line becomes a continuation row; in
+	// FormatMarkdown lines are joined with "<br>".
+	WrapCells bool
+
+	// WrapHeaders wraps each header in a text format onto as many lines as
+	// needed to fit the width of its column's data, breaking at spaces, so a
+	// long header does not force a narrow column to be wide.
+	WrapHeaders bool
+
+	// Hyphenate marks words split by WrapCells with a trailing "-".
+	Hyphenate bool
+
+	// TruncateUnit selects how MaxColumnWidth and HeaderMaxWidth are
+	// measured: runes (default), terminal display width, or bytes.
+	TruncateUnit TruncateUnit
+
+	// MaxColumnWidths sets per-column truncation widths, applied before
+	// MaxColumnWidth. Missing or 0 entries leave the column to MaxColumnWidth.
+	MaxColumnWidths []int
+
+	// MaxTableWidth constrains the total rendered width of FormatPlain,
+	// FormatSimple, and FormatMarkdown tables, including borders. Columns
+	// wider than their minimum shrink in proportion to their surplus.
+	// 0 = no limit.
+	MaxTableWidth int
+
+	// WidthPercentile sizes each column of a text-format table to this
+	// percentile of its cells' widths (but never narrower than its header),
+	// so one pathological value cannot blow a column out. Longer cells are
+	// truncated, or wrapped if WrapCells is set. 0 = size to the widest cell.
+	WidthPercentile int
+
+	// MinColumnWidths sets per-column minimum widths used when shrinking to
+	// MaxTableWidth. Missing entries default to DefaultMinColumnWidth.
+	MinColumnWidths []int
+
+	// ColumnPercents sets per-column widths as percentages of the space left
+	// for content in a text-format table. The total is MaxTableWidth, or the
+	// terminal width from the COLUMNS environment variable. Columns with no
+	// entry (or 0) keep their natural width. Ignored if no total is known.
+	ColumnPercents []int
+
+	// HeaderMaxWidth truncates header text longer than this. 0 = use
+	// MaxColumnWidth (or no limit if ExemptHeadersFromTruncation is set).
+	HeaderMaxWidth int
+
+	// ExemptHeadersFromTruncation applies MaxColumnWidth to data cells only,
+	// so headers are always shown in full unless HeaderMaxWidth is set.
+	ExemptHeadersFromTruncation bool
+
+	// RowTransforms are applied in order to a copy of every data row at
+	// render time, before Formatters. Each receives the row and returns its
+	// replacement; the table's stored rows are never modified.
+	RowTransforms []func([]string) []string `json:"-"`
+
+	// PostRender hooks are applied in order to the complete rendered output
+	// of every format, e.g. to add a prefix or wrap it in a code fence.
+	PostRender []func(string) string `json:"-"`
+
+	// MaxOutputBytes caps the length of the rendered output, after
+	// PostRender and Charset. Longer output is cut at the last line break
+	// that fits and ends with a notice, "[output truncated at N bytes]",
+	// within the budget; structured formats such as JSON are no longer
+	// valid once cut. RenderAppend does not support it. 0 = no limit.
+	MaxOutputBytes int
+
+	// Workers renders large FormatPlain, FormatSimple, FormatMarkdown, and
+	// FormatCSV tables on up to this many goroutines, each measuring and
+	// laying out a chunk of at least 1000 rows, and stitches the chunks in
+	// order. Tables with multi-line cells, WrapCells, or a custom Markdown
+	// style render sequentially. 0 or 1 = sequential.
+	Workers int
+
+	// MetaTransforms are like RowTransforms but also receive the row's
+	// metadata from Table.AddRowWithMeta (nil for rows without metadata).
+	// They run after RowTransforms.
+	MetaTransforms []func(row []string, meta RowMeta) []string `json:"-"`
+
+	// RowFilter, if set, keeps only the rows for which it returns true. It
+	// runs at render time, after MetaTransforms and before sorting and
+	// paging, and never modifies the table, so one Table can serve several
+	// views through RenderWith.
+	RowFilter func(row []string) bool `json:"-"`
+
+	// MetaFilter is like RowFilter but also receives the row's metadata
+	// from Table.AddRowWithMeta (nil for rows without metadata). It runs
+	// after RowFilter.
+	MetaFilter func(row []string, meta RowMeta) bool `json:"-"`
+
+	// Formatters sets per-column cell formatters, applied to raw cell values
+	// before any other processing. Nil entries leave the column unchanged.
+	Formatters []Formatter `json:"-"`
+
+	// StyleRules colors cells by declarative rules, keyed by header, e.g.
+	// {"Score": {{When: ">= 90", Color: "red"}}}. Rules see the raw cell
+	// value, before Formatters, and the first rule that matches wins. The
+	// ANSI codes take no width in text layouts. They are only added in
+	// FormatPlain, FormatSimple, FormatMarkdown, and FormatList, and not
+	// with NoColor; FormatHTML gives matching cells the rule's Class instead.
+	StyleRules map[string][]StyleRule
+
 	// NullPlaceholder is the string used for empty cells. Defaults to "".
 	NullPlaceholder string
 
-	// StrictColumnCount causes AddRow to return an error if column count mismatches.
+	// NullPlaceholders sets per-column placeholders for empty cells. If shorter
+	// than column count, or an entry is "", NullPlaceholder is used.
+	NullPlaceholders []string
+
+	// StrictColumnCount causes AddRow to return an error if column count
+	// mismatches, and rendering to fail if any row's count does, including
+	// rows passed to the package-level Render.
 	StrictColumnCount bool
+
+	// RaggedRows sets how rows with more or fewer cells than there are
+	// columns are corrected at render time, the same way in every format.
+	// It applies after RowTransforms and MetaTransforms. Defaults to
+	// RaggedKeep.
+	RaggedRows RaggedRows
+
+	// ExplicitNulls applies NullPlaceholder, NullPlaceholders, and CSVNull
+	// only to cells marked Null, e.g. by AddRowPtr, so genuinely empty
+	// strings render as empty.
+	ExplicitNulls bool
+
+	// CollapseRepeats sets, per column, whether a cell equal to the one above
+	// it is blanked in text formats, for a grouped-report look. A column only
+	// collapses while every collapsing column to its left also repeats.
+	CollapseRepeats []bool
+
+	// OmitEmptyColumns drops, at render time, every column whose data cells
+	// are all empty or equal to NullPlaceholder.
+	OmitEmptyColumns bool
+
+	// WideColumns lists headers of columns that are only rendered when Wide is true,
+	// mirroring kubectl's "-o wide".
+	WideColumns []string
+
+	// Wide includes the columns listed in WideColumns in the output.
+	Wide bool
+
+	// ColumnFilter selects the visible columns with glob patterns over the
+	// headers in path.Match syntax, e.g. "metric_*", applied in order. A
+	// pattern starting with "!" hides the columns it matches. If the first
+	// pattern is a hiding one, filtering starts from all columns, otherwise
+	// from none. Columns keep their original order.
+	ColumnFilter []string
+
+	// EscapeFormulas prefixes FormatCSV cells that start with =, +, -, @,
+	// tab, or carriage return with a single quote, so spreadsheet
+	// applications treat them as text rather than formulas. It applies to
+	// every CSVDialect, including CSVSheetsTSV, and to DiskTable output.
+	EscapeFormulas bool
+
+	// DuplicateHeaders controls how repeated header names are handled in
+	// FormatJSON, where each header becomes an object key. Defaults to
+	// DuplicateSuffix.
+	DuplicateHeaders DuplicateHeaderMode
+
+	// NestedJSON makes FormatJSON treat dots in headers as object nesting, so
+	// "user.name" and "user.city" become {"user": {"name": ..., "city": ...}}.
+	NestedJSON bool
+
+	// Schema validates every row before rendering, in any format, as the
+	// object FormatJSON would encode for it: typed columns as numbers or
+	// booleans, other cells as strings. Rendering fails with
+	// ErrSchemaViolation at the first row that does not match. See
+	// ParseJSONSchema.
+	Schema *Schema
+
+	// SanitizeUTF8 replaces invalid UTF-8 byte sequences in headers and cells
+	// before layout. Each invalid sequence becomes InvalidUTF8Marker.
+	SanitizeUTF8 bool
+
+	// InvalidUTF8Marker replaces invalid UTF-8 sequences when SanitizeUTF8 is
+	// set. Defaults to U+FFFD.
+	InvalidUTF8Marker string
+
+	// EmptyMessage is printed beneath the headers of a table with no rows in
+	// FormatPlain, FormatSimple, and FormatMarkdown, e.g. "(no rows)".
+	// CSV output is always headers only and JSON output is always "[]".
+	EmptyMessage string
+
+	// SplitWidth splits tables wider than this many characters into several
+	// stacked sub-tables, each holding as many whole columns as fit. Applies to
+	// FormatPlain, FormatSimple, and FormatMarkdown. 0 = never split.
+	SplitWidth int
+
+	// SplitAnchors sets, per column, whether the column is repeated in every
+	// sub-table when SplitWidth splits the table, e.g. an ID or name column
+	// that keeps rows identifiable. Columns keep their original order.
+	SplitAnchors []bool
+
+	// ResponsiveWidth drops columns, lowest ColumnPriorities first, until a
+	// text-format table fits in this many characters, and notes how many were
+	// hidden beneath it. 0 = never drop columns.
+	ResponsiveWidth int
+
+	// ColumnPriorities sets per-column priority for ResponsiveWidth. Higher
+	// values are kept longer; missing entries are 0. Among equal priorities
+	// the rightmost column is dropped first.
+	ColumnPriorities []int
+
+	// Footnotes attaches notes to cells or headers. In FormatPlain,
+	// FormatSimple, and FormatMarkdown the annotated cells get a superscript
+	// marker and the notes are listed beneath the table. Other formats
+	// ignore them, except FormatHTML, which rejects them with
+	// ErrInvalidOptions.
+	Footnotes []Footnote
+
+	// Footers are summary rows, e.g. totals, drawn after the data rows in
+	// FormatPlain, FormatSimple, and FormatMarkdown. They are not sorted,
+	// paged, or formatted like data rows.
+	Footers [][]string
+
+	// FooterSeparator selects the line drawn between the data rows and the
+	// Footers in FormatPlain and FormatSimple. Markdown has no row
+	// separators, so footers follow the data rows directly.
+	FooterSeparator FooterSeparator
+
+	// SortBy names the column whose values order the rows at render time.
+	// The sort is stable and happens before Formatters, so raw values are
+	// compared. "" = keep insertion order.
+	SortBy string
+
+	// SortMode selects how SortBy values are compared. Defaults to SortLexical.
+	SortMode SortMode
+
+	// SortKeys sorts rows by several columns, each with its own direction
+	// and mode; later keys break ties in earlier ones. It takes precedence
+	// over SortBy. The sort is stable.
+	SortKeys []SortKey
+
+	// SortFunc orders rows with domain logic no SortMode covers, such as a
+	// severity ranking. It returns a negative number when row a sorts before
+	// row b, a positive number when after, and 0 to keep their order. It
+	// takes precedence over SortKeys and SortBy.
+	SortFunc func(a, b []string) int `json:"-"`
+
+	// NoColor strips ANSI escape sequences from headers and cells, including
+	// those added by Formatters and StyleRules. FromEnv sets it when NO_COLOR
+	// is present.
+	NoColor bool
+
+	// CSVDialect tailors FormatCSV output to a consumer such as Excel or
+	// Google Sheets. Defaults to CSVStandard.
+	CSVDialect CSVDialect
+
+	// CSVDelimiter overrides the FormatCSV field delimiter, e.g. ';'. 0 = the
+	// dialect's default: ',' for CSVStandard, ExcelListSeparator for
+	// CSVExcel, and tab for CSVSheetsTSV.
+	CSVDelimiter rune
+
+	// CSVNull is written, unquoted, for empty cells in FormatCSV output in
+	// place of NullPlaceholder, e.g. `\N` for MySQL LOAD DATA or Redshift
+	// COPY. "" = empty cells stay empty.
+	CSVNull string
+
+	// CSVQuoting selects which FormatCSV fields are quoted. Defaults to
+	// CSVQuoteMinimal. The CSVNull token is never quoted, and CSVSheetsTSV
+	// never quotes.
+	CSVQuoting CSVQuoting
+
+	// CSVEscape, if set, escapes quotes inside FormatCSV fields by prefixing
+	// them with this character instead of doubling them, e.g. '\\' writes
+	// `"say \"hi\""`. The escape character itself is doubled.
+	CSVEscape rune
+
+	// Charset transcodes the complete rendered output, after PostRender, for
+	// downstream systems that cannot read UTF-8. Defaults to CharsetUTF8.
+	// Box-drawing borders are not representable in Latin-1, so pair it with
+	// FormatSimple, FormatMarkdown, or FormatCSV.
+	Charset Charset
+
+	// CharsetReplacement replaces characters Charset cannot represent.
+	// It must itself be representable. Defaults to "?".
+	CharsetReplacement string
+
+	// UniqueKey names a column whose values must be unique across rows, so
+	// reports cannot double-count an entry. See OnDuplicateKey.
+	UniqueKey string
+
+	// OnDuplicateKey controls how AddRow handles a repeated UniqueKey value.
+	// Defaults to DuplicateKeyError.
+	OnDuplicateKey DuplicateKeyMode
+
+	// Offset skips this many rows at render time, after sorting.
+	Offset int
+
+	// Limit renders at most this many rows, after Offset. 0 = no limit.
+	Limit int
+
+	// MinRows pads the body of FormatPlain, FormatSimple, and FormatMarkdown
+	// tables with blank rows up to this count, so successive renders of a
+	// fixed-size dashboard take the same vertical space. A table with no
+	// rows and an EmptyMessage is not padded.
+	MinRows int
+
+	// CacheWidths lets a Table remember the column widths it computes, so
+	// re-rendering unchanged data (a dashboard redrawing every frame) skips
+	// re-measuring every cell. The cache is cleared whenever rows or options
+	// change. RenderWith, which may change any option, and tables with
+	// Formatters, which may depend on the time as RelativeTime does, always
+	// measure.
+	CacheWidths bool
+
+	// CompactRows stores the cells of rows added to a Table in large shared
+	// buffers instead of one string per cell, greatly reducing the number
+	// of objects the garbage collector tracks for tables of millions of
+	// rows. Storage for rows replaced with SetRow or Upsert is only
+	// reclaimed by Reset.
+	CompactRows bool
+
+	// RowClass returns the CSS class for a data row in FormatHTML output,
+	// e.g. "error" for failing rows, so the host page can style it. It
+	// receives the row's cells after render-time processing; "" = no class.
+	RowClass func(row []string) string `json:"-"`
+
+	// MetaRowClass is like RowClass but also receives the row's metadata
+	// from Table.AddRowWithMeta, e.g. to style rows by a "severity" tag.
+	// RowClass takes precedence for rows it gives a class.
+	MetaRowClass func(row []string, meta RowMeta) string `json:"-"`
+
+	// CellData returns data-* attributes for a data cell in FormatHTML
+	// output, keyed by name without the "data-" prefix, e.g.
+	// {"sort-value": "1536"} for a cell displayed as "1.5 KiB". It receives
+	// the row's cells after render-time processing and the column index.
+	// Names may only use ASCII letters, digits, and hyphens; rendering
+	// fails with ErrInvalidOptions otherwise.
+	CellData func(row []string, col int) map[string]string `json:"-"`
+
+	// HTMLPage wraps FormatHTML output in a complete standalone page with
+	// inline CSS and a small script that sorts rows when a header is
+	// clicked, so a single exported file can be shared and browsed.
+	HTMLPage bool
+
+	// HTMLTitle is the <title> of the page written with HTMLPage.
+	// Defaults to "Table".
+	HTMLTitle string
+
+	// MarkdownLoose writes FormatMarkdown as a "loose" pipe table, without
+	// leading and trailing pipes and without padding cells to the column
+	// width. It is smaller and produces smaller diffs when a value changes.
+	MarkdownLoose bool
+
+	// MarkdownCompactSeparator writes the FormatMarkdown separator row
+	// without spaces around the dashes, e.g. "|:-----|------:|" instead of
+	// "| :--- | ---: |". Either way every separator cell has at least three
+	// dashes, as strict CommonMark/GFM parsers require.
+	MarkdownCompactSeparator bool
+
+	// widthScope attaches the Table's width cache to one render when
+	// CacheWidths is set.
+	widthScope *widthScope
+
+	// cuts records the data cells a render shortens, for RenderStats.
+	cuts *layoutCuts
+
+	// cellClasses holds the CSS class StyleRules give each cell of the
+	// prepared rows in FormatHTML output, indexed by row and column.
+	cellClasses [][]string
+
+	// rowClasses holds the MetaRowClass class of each prepared row.
+	rowClasses []string
 }
 
 // Table holds headers, rows, and rendering options.
 type Table struct {
 	opts Options
 	rows [][]string
+	meta []RowMeta
+
+	// appended is the number of rows already output by RenderAppend, and
+	// appendWidths and appendAligns the layout it fixed on its first call.
+	appended     int
+	appendWidths []int
+	appendAligns []Alignment
+
+	// widths caches column widths when Options.CacheWidths is set.
+	widths *widthCache
+
+	// arena stores rows when Options.CompactRows is set.
+	arena *rowArena
+
+	// keys maps each Options.UniqueKey cell to the row holding it, and
+	// keyCol is that column. It is built on first use and dropped whenever
+	// rows are reordered or cleared.
+	keys   map[string]int
+	keyCol int
 }
 
 // New creates a new Table with the provided Options.
@@ -82,24 +534,74 @@ type Table struct {
 //	    Format:  tablewriter.FormatMarkdown,
 //	})
 func New(opts Options) *Table {
-	return &Table{opts: opts}
+	return &Table{opts: opts, widths: &widthCache{}}
 }
 
 // AddRow appends a row of string values to the table.
 // Returns ErrColumnMismatch if StrictColumnCount is true and counts differ.
+// If UniqueKey is set and the row's key is already present, it returns
+// ErrDuplicateKey or, with DuplicateKeyOverwrite, replaces that row.
 //
 // Example:
 //
 //	err := t.AddRow("1", "active")
 func (t *Table) AddRow(cols ...string) error {
-	if t.opts.StrictColumnCount && len(t.opts.Headers) > 0 {
-		if len(cols) != len(t.opts.Headers) {
+	_, err := t.addRow(cols)
+	return err
+}
+
+// addRow is AddRow, also returning the index of the row it appended or,
+// with DuplicateKeyOverwrite, replaced.
+func (t *Table) addRow(cols []string) (int, error) {
+	if n := t.columnCount(); t.opts.StrictColumnCount && n > 0 {
+		if len(cols) != n {
+			return -1, ErrColumnMismatch
+		}
+	}
+	i, err := t.keyConflict(cols, -1)
+	if err != nil {
+		return -1, err
+	}
+	if i >= 0 {
+		if t.opts.OnDuplicateKey == DuplicateKeyOverwrite {
+			return i, t.SetRow(i, cols...)
+		}
+		return -1, t.duplicateKeyError(cols, i)
+	}
+	t.rows = append(t.rows, t.newRow(cols))
+	t.indexKey(len(t.rows)-1, nil, cols)
+	t.widths.reset()
+	t.meta = append(t.meta, nil)
+	return len(t.rows) - 1, nil
+}
+
+// SetRow replaces the row at index i.
+// Returns ErrRowOutOfRange if i is not an existing row, ErrColumnMismatch
+// if StrictColumnCount is true and counts differ, or ErrDuplicateKey if
+// UniqueKey is set and another row already has the new key.
+//
+// Example:
+//
+//	err := t.SetRow(0, "1", "done")
+func (t *Table) SetRow(i int, cols ...string) error {
+	if i < 0 || i >= len(t.rows) {
+		return ErrRowOutOfRange
+	}
+	if n := t.columnCount(); t.opts.StrictColumnCount && n > 0 {
+		if len(cols) != n {
 			return ErrColumnMismatch
 		}
 	}
-	row := make([]string, len(cols))
-	copy(row, cols)
-	t.rows = append(t.rows, row)
+	j, err := t.keyConflict(cols, i)
+	if err != nil {
+		return err
+	}
+	if j >= 0 {
+		return t.duplicateKeyError(cols, j)
+	}
+	t.indexKey(i, t.rows[i], cols)
+	t.rows[i] = t.newRow(cols)
+	t.widths.reset()
 	return nil
 }
 
@@ -139,7 +641,31 @@ func (t *Table) Render() string {
 //	    log.Fatal(err)
 //	}
 func (t *Table) RenderErr() (string, error) {
-	return render(t.opts, t.rows)
+	return t.renderAs(t.withWidthCache(t.opts, widthUseRender))
+}
+
+// RenderWith renders the table with opts in place of its own options, e.g.
+// with a different RowFilter or Format. It does not modify the table, so
+// several goroutines may render views of it at once as long as none adds or
+// changes rows.
+//
+// Example:
+//
+//	errorsOnly := t.Options()
+//	errorsOnly.RowFilter = func(row []string) bool { return row[2] == "error" }
+//	out, err := t.RenderWith(errorsOnly)
+func (t *Table) RenderWith(opts Options) (string, error) {
+	return t.renderAs(opts)
+}
+
+// renderAs renders the table's rows and metadata with opts in place of the
+// table's own options.
+func (t *Table) renderAs(opts Options) (string, error) {
+	opts, rows, err := prepare(opts, t.rows, t.meta)
+	if err != nil {
+		return "", err
+	}
+	return render(opts, rows)
 }
 
 // Reset clears all rows while preserving options and headers.
@@ -149,6 +675,13 @@ func (t *Table) RenderErr() (string, error) {
 //	t.Reset()
 func (t *Table) Reset() {
 	t.rows = nil
+	t.meta = nil
+	t.arena = nil
+	t.keys = nil
+	t.widths.reset()
+	t.appended = 0
+	t.appendWidths = nil
+	t.appendAligns = nil
 }
 
 // RowCount returns the number of data rows currently in the table.
@@ -160,8 +693,54 @@ func (t *Table) RowCount() int {
 	return len(t.rows)
 }
 
+// Options returns a copy of the table's options, for deriving the options
+// passed to RenderWith. Slice and map fields share storage with the table's
+// and must not be modified in place.
+//
+// Example:
+//
+//	md := t.Options()
+//	md.Format = tablewriter.FormatMarkdown
+func (t *Table) Options() Options {
+	return t.opts
+}
+
+// Headers returns a copy of the table's header names.
+//
+// Example:
+//
+//	hs := t.Headers()
+func (t *Table) Headers() []string {
+	headers := resolveColumns(t.opts).Headers
+	hs := make([]string, len(headers))
+	copy(hs, headers)
+	return hs
+}
+
+// columnCount returns the number of declared columns, from Columns or Headers.
+func (t *Table) columnCount() int {
+	if len(t.opts.Columns) > 0 {
+		return len(t.opts.Columns)
+	}
+	return len(t.opts.Headers)
+}
+
+// Rows returns a deep copy of the table's data rows.
+//
+// Example:
+//
+//	for _, r := range t.Rows() {
+//	    fmt.Println(r)
+//	}
+func (t *Table) Rows() [][]string {
+	return cloneRows(t.rows)
+}
+
 // Render is a package-level convenience function. It creates a table with the
 // given options and rows and returns the rendered string.
+// Rows are held to the same invariants as rows added with AddRow: with
+// StrictColumnCount it returns ErrColumnMismatch if a row's cell count
+// differs from the header count, and RaggedRows applies as for a Table.
 //
 // Example:
 //
@@ -170,5 +749,32 @@ func (t *Table) RowCount() int {
 //	    [][]string{{"x","y"},{"1","2"}},
 //	)
 func Render(opts Options, rows [][]string) (string, error) {
+	opts, rows, err := prepare(opts, rows, nil)
+	if err != nil {
+		return "", err
+	}
 	return render(opts, rows)
 }
+
+// duplicateHeaderNames maps each DuplicateHeaderMode to its name in configuration files.
+var duplicateHeaderNames = map[DuplicateHeaderMode]string{
+	DuplicateSuffix: "suffix",
+	DuplicateError:  "error",
+}
+
+// MarshalText encodes m by name, e.g. "suffix", implementing
+// encoding.TextMarshaler.
+func (m DuplicateHeaderMode) MarshalText() ([]byte, error) {
+	return marshalEnum(m, duplicateHeaderNames, "DuplicateHeaderMode")
+}
+
+// UnmarshalText parses a name written by MarshalText, ignoring case,
+// implementing encoding.TextUnmarshaler.
+func (m *DuplicateHeaderMode) UnmarshalText(text []byte) error {
+	v, err := unmarshalEnum(text, duplicateHeaderNames, "DuplicateHeaderMode")
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
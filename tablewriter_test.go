@@ -1,179 +1,221 @@
 package tablewriter_test
 
-// Package tablewriter_test contains tests for the tablewriter package.
 import (
-	"context"
 	"errors"
-	"strings"
+	"fmt"
 	"testing"
 
 	"github.com/njchilds90/go-tablewriter"
 )
 
-// ErrInvalidFormat is a sentinel error indicating an invalid format.
-var ErrInvalidFormat = errors.New("invalid format")
-
-// ErrInvalidOptions is a sentinel error indicating invalid options.
-var ErrInvalidOptions = errors.New("invalid options")
+func TestRenderFormats(t *testing.T) {
+	headers := []string{"Name", "Age", "City"}
+	rows := [][]string{{"Alice", "30", "New York"}, {"Bob", "25", "Los Angeles"}}
+	tests := []struct {
+		name   string
+		format tablewriter.Format
+		want   string
+	}{
+		{
+			"plain",
+			tablewriter.FormatPlain,
+			"┌───────┬─────┬─────────────┐\n" +
+				"│ Name  │ Age │ City        │\n" +
+				"├───────┼─────┼─────────────┤\n" +
+				"│ Alice │ 30  │ New York    │\n" +
+				"│ Bob   │ 25  │ Los Angeles │\n" +
+				"└───────┴─────┴─────────────┘\n",
+		},
+		{
+			"simple",
+			tablewriter.FormatSimple,
+			"Name   Age  City       \n" +
+				"─────  ───  ───────────\n" +
+				"Alice  30   New York   \n" +
+				"Bob    25   Los Angeles\n",
+		},
+		{
+			"markdown",
+			tablewriter.FormatMarkdown,
+			"| Name  | Age | City        |\n" +
+				"| ----- | --- | ----------- |\n" +
+				"| Alice | 30  | New York    |\n" +
+				"| Bob   | 25  | Los Angeles |\n",
+		},
+		{
+			"csv",
+			tablewriter.FormatCSV,
+			"Name,Age,City\nAlice,30,New York\nBob,25,Los Angeles\n",
+		},
+		{
+			"json",
+			tablewriter.FormatJSON,
+			"[\n" +
+				"  {\n    \"Age\": \"30\",\n    \"City\": \"New York\",\n    \"Name\": \"Alice\"\n  },\n" +
+				"  {\n    \"Age\": \"25\",\n    \"City\": \"Los Angeles\",\n    \"Name\": \"Bob\"\n  }\n" +
+				"]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := tablewriter.Render(tablewriter.Options{Headers: headers, Format: tt.format}, rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() =\n%s\nwant\n%s", out, tt.want)
+			}
+		})
+	}
+}
 
-func TestRenderPlain(t *testing.T) {
-	// Test cases for RenderPlain.
+func TestRenderOptions(t *testing.T) {
 	tests := []struct {
-		name     string
-		headers  []string
-		rows     [][]string
-		wantOut  string
-		wantErr  bool
-	}{{
-		"valid input",
-		[]string{"Name", "Age", "City"},
-		[][]string{{"Alice", "30", "New York"}, {"Bob", "25", "Los Angeles"}},
-		"Alice\nBob\n30\n25\nNew York\nLos Angeles",
-		false,
-	},{
-		"invalid format",
-		[]string{"Name"},
-		[][]string{{"Alice"}},
-		"",
-		true,
-	},{
-		"empty input",
-		[]string{},
-		[][]string{},
-		"",
-		false,
-	}}
+		name string
+		opts tablewriter.Options
+		rows [][]string
+		want string
+	}{
+		{
+			"alignments",
+			tablewriter.Options{
+				Headers:    []string{"Item", "Qty", "Note"},
+				Format:     tablewriter.FormatMarkdown,
+				Alignments: []tablewriter.Alignment{tablewriter.AlignLeft, tablewriter.AlignRight, tablewriter.AlignCenter},
+			},
+			[][]string{{"apple", "3", "ok"}, {"kiwi", "12", "ripe"}},
+			"| Item  |  Qty | Note  |\n" +
+				"| ----- | ---: | :---: |\n" +
+				"| apple |    3 |  ok   |\n" +
+				"| kiwi  |   12 | ripe  |\n",
+		},
+		{
+			"markdown escapes pipes",
+			tablewriter.Options{Headers: []string{"Expr"}, Format: tablewriter.FormatMarkdown},
+			[][]string{{"a|b"}},
+			"| Expr |\n| ---- |\n| a\\|b |\n",
+		},
+		{
+			"null placeholder and max width",
+			tablewriter.Options{Format: tablewriter.FormatPlain, NullPlaceholder: "-", MaxColumnWidth: 6},
+			[][]string{{"abcdefghij", ""}},
+			"┌────────┬───┐\n│ abc... │ - │\n└────────┴───┘\n",
+		},
+		{
+			"short rows",
+			tablewriter.Options{Headers: []string{"A", "B"}, Format: tablewriter.FormatSimple},
+			[][]string{{"x"}, {"y", "z"}},
+			"A  B\n─  ─\nx   \ny  z\n",
+		},
+	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctx := context.Background()
-			opts := tablewriter.Options{
-				Headers: tt.headers,
-				Format:  tablewriter.FormatPlain,
+			out, err := tablewriter.Render(tt.opts, tt.rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
 			}
-			out, err := tablewriter.RenderWithContext(ctx, opts, tt.rows)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("RenderPlain() error = %v, wantErr %v", err, tt.wantErr)
-				return
+			if out != tt.want {
+				t.Errorf("Render() =\n%q\nwant\n%q", out, tt.want)
 			}
-			if !strings.Contains(out, tt.wantOut) {
-				t.Errorf("RenderPlain() got = %v, want %v", out, tt.wantOut)
+		})
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		opts tablewriter.Options
+		want error
+	}{
+		{"unknown format", tablewriter.Options{Headers: []string{"A"}, Format: tablewriter.Format(99)}, tablewriter.ErrInvalidFormat},
+		{"json without headers", tablewriter.Options{Format: tablewriter.FormatJSON}, tablewriter.ErrMissingHeaders},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tablewriter.Render(tt.opts, [][]string{{"x"}})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Render() error = %v, want %v", err, tt.want)
 			}
 		})
 	}
 }
 
-func TestRenderMarkdown(t *testing.T) {
-		// Test cases for RenderMarkdown.
-		tests := []struct {
-			name     string
-			headers  []string
-			rows     [][]string
-			wantOut  string
-			wantErr  bool
-		}{{
-			"valid input",
-			[]string{"Name", "Score"},
-			[][]string{{"Alice", "95"}, {"Bob", "87"}},
-			"| Name | Score |\n| --- | --- |\n| Alice | 95 |\n| Bob | 87 |",
-			false,
-		},{
-			"invalid format",
-			[]string{"Name"},
-			[][]string{{"Alice"}},
-			"",
-			true,
-		},{
-			"empty input",
-			[]string{},
-			[][]string{},
-			"",
-			false,
-		}}
-		for _, tt := range tests {
-			t.Run(tt.name, func(t *testing.T) {
-				ctx := context.Background()
-				opts := tablewriter.Options{
-					Headers: tt.headers,
-					Format:  tablewriter.FormatMarkdown,
-				}
-				out, err := tablewriter.RenderWithContext(ctx, opts, tt.rows)
-				if (err != nil) != tt.wantErr {
-					t.Errorf("RenderMarkdown() error = %v, wantErr %v", err, tt.wantErr)
-					return
-				}
-				if !strings.Contains(out, tt.wantOut) {
-					t.Errorf("RenderMarkdown() got = %v, want %v", out, tt.wantOut)
-				}
-			})
-		}
+func TestTable(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{
+		Headers:           []string{"ID", "Status"},
+		Format:            tablewriter.FormatCSV,
+		StrictColumnCount: true,
+	})
+	if err := tbl.AddRows([][]string{{"1", "active"}, {"2", "pending"}}); err != nil {
+		t.Fatalf("AddRows() error = %v", err)
+	}
+	if err := tbl.AddRow("3"); !errors.Is(err, tablewriter.ErrColumnMismatch) {
+		t.Errorf("AddRow() error = %v, want ErrColumnMismatch", err)
+	}
+	if got := tbl.RowCount(); got != 2 {
+		t.Errorf("RowCount() = %d, want 2", got)
+	}
+	if got, want := tbl.Render(), "ID,Status\n1,active\n2,pending\n"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+	tbl.Reset()
+	if got := tbl.RowCount(); got != 0 {
+		t.Errorf("RowCount() after Reset = %d, want 0", got)
+	}
 }
 
-func TestRenderCSV(t *testing.T) {
-		// Test cases for RenderCSV.
-		tests := []struct {
-			name     string
-			headers  []string
-			rows     [][]string
-			wantOut  string
-			wantErr  bool
-		}{{
-			"valid input",
-			[]string{"ID", "Status"},
-			[][]string{{"1", "active"}, {"2", "pending"}},
-			"ID,Status\n1,active\n2,pending",
-			false,
-		},{
-			"invalid format",
-			[]string{"Name"},
-			[][]string{{"Alice"}},
-			"",
-			true,
-		},{
-			"empty input",
-			[]string{},
-			[][]string{},
-			"",
-			false,
-		}}
-		for _, tt := range tests {
-			t.Run(tt.name, func(t *testing.T) {
-				ctx := context.Background()
-				opts := tablewriter.Options{
-					Headers: tt.headers,
-					Format:  tablewriter.FormatCSV,
-				}
-				out, err := tablewriter.RenderWithContext(ctx, opts, tt.rows)
-				if (err != nil) != tt.wantErr {
-					t.Errorf("RenderCSV() error = %v, wantErr %v", err, tt.wantErr)
-					return
-				}
-				if !strings.Contains(out, tt.wantOut) {
-					t.Errorf("RenderCSV() got = %v, want %v", out, tt.wantOut)
-				}
-			})
-		}
+func TestWithFormat(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithFormat(tablewriter.Format(99)); !errors.Is(err, tablewriter.ErrInvalidOptions) {
+		t.Errorf("WithFormat(99) error = %v, want ErrInvalidOptions", err)
+	}
+	opts, err := tablewriter.DefaultOptions().WithFormat(tablewriter.FormatJSON)
+	if err != nil || opts.Format != tablewriter.FormatJSON {
+		t.Errorf("WithFormat(FormatJSON) = %v, %v", opts.Format, err)
+	}
 }
 
 func BenchmarkRenderPlain(b *testing.B) {
-	// Benchmark RenderPlain.
-	ctx := context.Background()
 	opts := tablewriter.Options{
 		Headers: []string{"Name", "Age", "City"},
 		Format:  tablewriter.FormatPlain,
 	}
 	rows := [][]string{{"Alice", "30", "New York"}, {"Bob", "25", "Los Angeles"}}
 	for i := 0; i < b.N; i++ {
-		tablewriter.RenderWithContext(ctx, opts, rows)
+		if _, err := tablewriter.Render(opts, rows); err != nil {
+			b.Fatal(err)
+		}
 	}
 }
 
-func ExampleRenderPlain() {
-	// Example usage of RenderPlain.
-	ctx := context.Background()
-	opts := tablewriter.Options{
-		Headers: []string{"Name", "Age", "City"},
-		Format:  tablewriter.FormatPlain,
+func ExampleRender() {
+	out, _ := tablewriter.Render(
+		tablewriter.Options{Headers: []string{"Name", "Age"}, Format: tablewriter.FormatPlain},
+		[][]string{{"Alice", "30"}, {"Bob", "25"}},
+	)
+	fmt.Print(out)
+	// Output:
+	// ┌───────┬─────┐
+	// │ Name  │ Age │
+	// ├───────┼─────┤
+	// │ Alice │ 30  │
+	// │ Bob   │ 25  │
+	// └───────┴─────┘
+}
+
+func TestWithMaxColumnWidths(t *testing.T) {
+	if _, err := tablewriter.DefaultOptions().WithMaxColumnWidths(4, -1); !errors.Is(err, tablewriter.ErrInvalidColumnWidth) {
+		t.Errorf("WithMaxColumnWidths(4, -1) error = %v, want ErrInvalidColumnWidth", err)
 	}
-	rows := [][]string{{"Alice", "30", "New York"}, {"Bob", "25", "Los Angeles"}}
-	out, _ := tablewriter.RenderWithContext(ctx, opts, rows)
-	// Output: Alice\nBob\n30\n25\nNew York\nLos Angeles
+	opts, err := tablewriter.DefaultOptions().WithMaxColumnWidths(0, 4)
+	if err != nil {
+		t.Fatalf("WithMaxColumnWidths() error = %v", err)
+	}
+	opts.Format = tablewriter.FormatCSV
+	out, err := tablewriter.Render(opts, [][]string{{"unlimited", "truncated"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "unlimited,t...\n"; out != want {
+		t.Errorf("Render() = %q, want %q", out, want)
+	}
+}
@@ -0,0 +1,157 @@
+// Package tablewritertest provides assertion and golden-file helpers for
+// testing code that builds tablewriter tables. Mismatches are reported as
+// cell-level diffs rather than raw string comparisons.
+//
+// # Quick Start
+//
+//	func TestReport(t *testing.T) {
+//	    want := tablewriter.New(tablewriter.Options{Headers: []string{"ID", "Status"}})
+//	    want.AddRow("1", "active")
+//	    tablewritertest.AssertEqual(t, want, buildReport())
+//	}
+package tablewritertest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+// UpdateEnv is the environment variable that, when set to "1", makes
+// AssertGolden rewrite golden files instead of comparing against them.
+const UpdateEnv = "TABLEWRITER_UPDATE_GOLDEN"
+
+// Diff compares two tables cell by cell and returns one line per difference.
+// It returns nil when the headers and all rows are equal.
+//
+// Example:
+//
+//	for _, d := range tablewritertest.Diff(want, got) {
+//	    fmt.Println(d)
+//	}
+func Diff(want, got *tablewriter.Table) []string {
+	var diffs []string
+	wh, gh := want.Headers(), got.Headers()
+	for i := 0; i < max(len(wh), len(gh)); i++ {
+		w, wok := at(wh, i)
+		g, gok := at(gh, i)
+		if w != g || wok != gok {
+			diffs = append(diffs, fmt.Sprintf("header %d: want %s, got %s", i, quote(w, wok), quote(g, gok)))
+		}
+	}
+
+	wr, gr := want.Rows(), got.Rows()
+	if len(wr) != len(gr) {
+		diffs = append(diffs, fmt.Sprintf("row count: want %d, got %d", len(wr), len(gr)))
+	}
+	for r := 0; r < min(len(wr), len(gr)); r++ {
+		for c := 0; c < max(len(wr[r]), len(gr[r])); c++ {
+			w, wok := at(wr[r], c)
+			g, gok := at(gr[r], c)
+			if w != g || wok != gok {
+				diffs = append(diffs, fmt.Sprintf("row %d, %s: want %s, got %s", r, columnName(wh, c), quote(w, wok), quote(g, gok)))
+			}
+		}
+	}
+	return diffs
+}
+
+// AssertEqual fails t with a cell-level diff if want and got differ.
+//
+// Example:
+//
+//	tablewritertest.AssertEqual(t, want, got)
+func AssertEqual(t testing.TB, want, got *tablewriter.Table) {
+	t.Helper()
+	if diffs := Diff(want, got); len(diffs) > 0 {
+		t.Errorf("tables differ:\n  %s", strings.Join(diffs, "\n  "))
+	}
+}
+
+// AssertGolden renders got and compares it to the contents of the golden file
+// at path. When the UpdateEnv environment variable is "1", the golden file is
+// written instead. Differences are reported line by line.
+//
+// Example:
+//
+//	tablewritertest.AssertGolden(t, "testdata/report.golden", got)
+func AssertGolden(t testing.TB, path string, got *tablewriter.Table) {
+	t.Helper()
+	out, err := got.RenderErr()
+	if err != nil {
+		t.Fatalf("rendering table: %v", err)
+	}
+
+	if os.Getenv(UpdateEnv) == "1" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("creating golden dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(out), 0o644); err != nil {
+			t.Fatalf("writing golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("golden file %s does not exist; run with %s=1 to create it", path, UpdateEnv)
+	}
+	if err != nil {
+		t.Fatalf("reading golden file: %v", err)
+	}
+	if diffs := DiffLines(string(want), out); len(diffs) > 0 {
+		t.Errorf("output does not match %s:\n  %s", path, strings.Join(diffs, "\n  "))
+	}
+}
+
+// DiffLines compares two rendered outputs line by line and returns one entry
+// per differing line. It returns nil when the outputs are equal.
+//
+// Example:
+//
+//	diffs := tablewritertest.DiffLines(wantOutput, gotOutput)
+func DiffLines(want, got string) []string {
+	if want == got {
+		return nil
+	}
+	wl := strings.Split(want, "\n")
+	gl := strings.Split(got, "\n")
+	var diffs []string
+	for i := 0; i < max(len(wl), len(gl)); i++ {
+		w, wok := at(wl, i)
+		g, gok := at(gl, i)
+		if w != g || wok != gok {
+			diffs = append(diffs, fmt.Sprintf("line %d:\n    - %s\n    + %s", i+1, quote(w, wok), quote(g, gok)))
+		}
+	}
+	return diffs
+}
+
+// at returns s[i] and whether i was in range.
+func at(s []string, i int) (string, bool) {
+	if i < len(s) {
+		return s[i], true
+	}
+	return "", false
+}
+
+// quote formats a cell for diff output, marking absent cells explicitly.
+func quote(s string, ok bool) string {
+	if !ok {
+		return "<missing>"
+	}
+	return fmt.Sprintf("%q", s)
+}
+
+// columnName returns the header for column c, or its index if there is none.
+func columnName(headers []string, c int) string {
+	if c < len(headers) && headers[c] != "" {
+		return fmt.Sprintf("column %q", headers[c])
+	}
+	return fmt.Sprintf("column %d", c)
+}
@@ -0,0 +1,67 @@
+package tablewritertest_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+	"github.com/njchilds90/go-tablewriter/tablewritertest"
+)
+
+func newTable(headers []string, rows ...[]string) *tablewriter.Table {
+	t := tablewriter.New(tablewriter.Options{Headers: headers})
+	t.AddRows(rows)
+	return t
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		want *tablewriter.Table
+		got  *tablewriter.Table
+		diff []string
+	}{{
+		"equal",
+		newTable([]string{"ID", "Status"}, []string{"1", "active"}),
+		newTable([]string{"ID", "Status"}, []string{"1", "active"}),
+		nil,
+	}, {
+		"changed cell",
+		newTable([]string{"ID", "Status"}, []string{"1", "active"}),
+		newTable([]string{"ID", "Status"}, []string{"1", "pending"}),
+		[]string{`row 0, column "Status": want "active", got "pending"`},
+	}, {
+		"missing cell",
+		newTable([]string{"ID", "Status"}, []string{"1", "active"}),
+		newTable([]string{"ID", "Status"}, []string{"1"}),
+		[]string{`row 0, column "Status": want "active", got <missing>`},
+	}, {
+		"row count",
+		newTable([]string{"ID"}, []string{"1"}, []string{"2"}),
+		newTable([]string{"ID"}, []string{"1"}),
+		[]string{"row count: want 2, got 1"},
+	}, {
+		"header",
+		newTable([]string{"ID"}),
+		newTable([]string{"Key"}),
+		[]string{`header 0: want "ID", got "Key"`},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tablewritertest.Diff(tt.want, tt.got)
+			if !reflect.DeepEqual(got, tt.diff) {
+				t.Errorf("Diff() = %q, want %q", got, tt.diff)
+			}
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	if d := tablewritertest.DiffLines("a\nb", "a\nb"); d != nil {
+		t.Errorf("DiffLines() on equal input = %q, want nil", d)
+	}
+	want := []string{"line 2:\n    - \"b\"\n    + \"c\""}
+	if d := tablewritertest.DiffLines("a\nb", "a\nc"); !reflect.DeepEqual(d, want) {
+		t.Errorf("DiffLines() = %q, want %q", d, want)
+	}
+}
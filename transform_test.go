@@ -0,0 +1,72 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestRowTransforms(t *testing.T) {
+	redact := func(r []string) []string {
+		r[1] = "***"
+		return r
+	}
+	upper := func(r []string) []string {
+		for i := range r {
+			r[i] = strings.ToUpper(r[i])
+		}
+		return r
+	}
+	tests := []struct {
+		name       string
+		transforms []func([]string) []string
+		want       string
+	}{
+		{"none", nil, "alice,secret\n"},
+		{"single", []func([]string) []string{redact}, "alice,***\n"},
+		{"in order", []func([]string) []string{upper, redact}, "ALICE,***\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := tablewriter.New(tablewriter.Options{Format: tablewriter.FormatCSV, RowTransforms: tt.transforms})
+			tbl.AddRow("alice", "secret")
+			out, err := tbl.RenderErr()
+			if err != nil {
+				t.Fatalf("RenderErr() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("RenderErr() = %q, want %q", out, tt.want)
+			}
+			if got := tbl.Rows()[0][1]; got != "secret" {
+				t.Errorf("stored row modified: got %q, want %q", got, "secret")
+			}
+		})
+	}
+}
+
+func TestPostRender(t *testing.T) {
+	fence := func(s string) string { return "```\n" + s + "```\n" }
+	indent := func(s string) string { return "  " + strings.ReplaceAll(strings.TrimSuffix(s, "\n"), "\n", "\n  ") + "\n" }
+	tests := []struct {
+		name  string
+		hooks []func(string) string
+		want  string
+	}{
+		{"none", nil, "a,b\n"},
+		{"fence", []func(string) string{fence}, "```\na,b\n```\n"},
+		{"in order", []func(string) string{fence, indent}, "  ```\n  a,b\n  ```\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Format: tablewriter.FormatCSV, PostRender: tt.hooks}
+			out, err := tablewriter.Render(opts, [][]string{{"a", "b"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestHeaderTruncation(t *testing.T) {
+	tests := []struct {
+		name       string
+		exempt     bool
+		headerMax  int
+		wantHeader string
+		wantCell   string
+	}{
+		{"truncated with cells", false, 0, "Environ...", "product..."},
+		{"exempt", true, 0, "Environment", "product..."},
+		{"separate limit", false, 5, "En...", "product..."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:                     []string{"Environment"},
+				Format:                      tablewriter.FormatCSV,
+				MaxColumnWidth:              10,
+				HeaderMaxWidth:              tt.headerMax,
+				ExemptHeadersFromTruncation: tt.exempt,
+			}
+			out, err := tablewriter.Render(opts, [][]string{{"production-eu"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			want := tt.wantHeader + "\n" + tt.wantCell + "\n"
+			if !strings.Contains(out, want) {
+				t.Errorf("Render() = %q, want it to contain %q", out, want)
+			}
+		})
+	}
+}
+
+func TestTruncateUnit(t *testing.T) {
+	tests := []struct {
+		name string
+		unit tablewriter.TruncateUnit
+		max  int
+		cell string
+		want string
+	}{
+		{"runes", tablewriter.TruncateRunes, 6, "日本語のテキスト", "日本語..."},
+		{"display width", tablewriter.TruncateDisplayWidth, 7, "日本語のテキスト", "日本..."},
+		{"bytes", tablewriter.TruncateBytes, 10, "日本語のテキスト", "日本..."},
+		{"bytes never split rune", tablewriter.TruncateBytes, 8, "日本語", "日..."},
+		{"fits", tablewriter.TruncateBytes, 9, "日本語", "日本語"},
+		{"tiny limit", tablewriter.TruncateDisplayWidth, 3, "日本語", "日"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Format:         tablewriter.FormatCSV,
+				MaxColumnWidth: tt.max,
+				TruncateUnit:   tt.unit,
+			}
+			out, err := tablewriter.Render(opts, [][]string{{tt.cell}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want+"\n" {
+				t.Errorf("Render() = %q, want %q", out, tt.want+"\n")
+			}
+		})
+	}
+}
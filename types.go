@@ -0,0 +1,188 @@
+package tablewriter
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ColumnType declares the kind of data a column holds. Declaring it once
+// sets the column's default alignment, how it sorts, and how FormatJSON
+// encodes its values.
+type ColumnType int
+
+const (
+	TypeString ColumnType = iota // TypeString holds text (default).
+	TypeInt                      // TypeInt holds integers; right-aligned, sorted and encoded as numbers.
+	TypeFloat                    // TypeFloat holds decimals; right-aligned, sorted and encoded as numbers.
+	TypeBool                     // TypeBool holds strconv.ParseBool values; sorted false first, encoded as booleans.
+	TypeTime                     // TypeTime holds RFC 3339 or "2006-01-02[ 15:04:05]" times; sorted chronologically.
+)
+
+// timeLayouts are the layouts TypeTime values are parsed with, in order.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"}
+
+// WithColumnTypes returns a copy of Options with per-column data types.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithColumnTypes(tablewriter.TypeString, tablewriter.TypeInt)
+func (o Options) WithColumnTypes(types ...ColumnType) Options {
+	o.ColumnTypes = types
+	return o
+}
+
+// numeric reports whether values of type t are numbers.
+func (t ColumnType) numeric() bool {
+	return t == TypeInt || t == TypeFloat
+}
+
+// parse converts v to a Go value of type t: int64, float64, bool,
+// time.Time, or string. ok is false if v is not a valid value of the type.
+func (t ColumnType) parse(v string) (any, bool) {
+	v = strings.TrimSpace(v)
+	switch t {
+	case TypeInt:
+		n, err := strconv.ParseInt(v, 10, 64)
+		return n, err == nil
+	case TypeFloat:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	case TypeBool:
+		b, err := strconv.ParseBool(v)
+		return b, err == nil
+	case TypeTime:
+		for _, layout := range timeLayouts {
+			if tm, err := time.Parse(layout, v); err == nil {
+				return tm, true
+			}
+		}
+		return nil, false
+	default:
+		return v, true
+	}
+}
+
+// compare orders a and b by their typed values. Values that do not parse
+// sort after those that do, in byte order among themselves.
+func (t ColumnType) compare(a, b string) int {
+	va, okA := t.parse(a)
+	vb, okB := t.parse(b)
+	switch {
+	case !okA && !okB:
+		return strings.Compare(a, b)
+	case !okA:
+		return 1
+	case !okB:
+		return -1
+	}
+	switch x := va.(type) {
+	case int64:
+		return compareOrdered(x, vb.(int64))
+	case float64:
+		return compareOrdered(x, vb.(float64))
+	case bool:
+		y := vb.(bool)
+		if x == y {
+			return 0
+		}
+		if y {
+			return -1
+		}
+		return 1
+	case time.Time:
+		return x.Compare(vb.(time.Time))
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// compareOrdered returns -1, 0, or +1 as a is less than, equal to, or
+// greater than b.
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// jsonValue returns the value FormatJSON encodes for cell v: a number or
+// boolean for typed columns, nil for an empty typed cell, and the string
+// itself otherwise.
+func (t ColumnType) jsonValue(v string) any {
+	if t == TypeString || t == TypeTime {
+		return v
+	}
+	if strings.TrimSpace(v) == "" {
+		return nil
+	}
+	if pv, ok := t.parse(v); ok {
+		return pv
+	}
+	return v
+}
+
+// typeAt returns the declared type of column col.
+func typeAt(opts Options, col int) ColumnType {
+	if col < len(opts.ColumnTypes) {
+		return opts.ColumnTypes[col]
+	}
+	return TypeString
+}
+
+// hasColumnTypes reports whether any column has a type other than TypeString.
+func hasColumnTypes(opts Options) bool {
+	for _, t := range opts.ColumnTypes {
+		if t != TypeString {
+			return true
+		}
+	}
+	return false
+}
+
+// typedAlignments right-aligns numeric columns whose alignment is left at
+// the default.
+func typedAlignments(opts Options) []Alignment {
+	n := len(opts.Alignments)
+	if len(opts.ColumnTypes) > n {
+		n = len(opts.ColumnTypes)
+	}
+	aligns := make([]Alignment, n)
+	copy(aligns, opts.Alignments)
+	for i, t := range opts.ColumnTypes {
+		if t.numeric() && aligns[i] == AlignLeft {
+			aligns[i] = AlignRight
+		}
+	}
+	return aligns
+}
+
+// columnTypeNames maps each ColumnType to its name in configuration files.
+var columnTypeNames = map[ColumnType]string{
+	TypeString: "string",
+	TypeInt:    "int",
+	TypeFloat:  "float",
+	TypeBool:   "bool",
+	TypeTime:   "time",
+}
+
+// MarshalText encodes t by name, e.g. "float", implementing
+// encoding.TextMarshaler.
+func (t ColumnType) MarshalText() ([]byte, error) {
+	return marshalEnum(t, columnTypeNames, "ColumnType")
+}
+
+// UnmarshalText parses a name written by MarshalText, ignoring case,
+// implementing encoding.TextUnmarshaler.
+func (t *ColumnType) UnmarshalText(text []byte) error {
+	v, err := unmarshalEnum(text, columnTypeNames, "ColumnType")
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
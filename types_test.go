@@ -0,0 +1,52 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestColumnTypesSort(t *testing.T) {
+	rows := [][]string{{"b", "10", "2024-03-01"}, {"a", "9", "2023-12-31"}, {"c", "n/a", "2024-01-15"}}
+	tests := []struct {
+		name string
+		by   string
+		want string
+	}{
+		{"int", "Count", "a,9,2023-12-31\nb,10,2024-03-01\nc,n/a,2024-01-15\n"},
+		{"time", "Seen", "a,9,2023-12-31\nc,n/a,2024-01-15\nb,10,2024-03-01\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Format: tablewriter.FormatCSV}.WithColumns(
+				tablewriter.Column{Name: "Name"},
+				tablewriter.Column{Name: "Count", Type: tablewriter.TypeInt},
+				tablewriter.Column{Name: "Seen", Type: tablewriter.TypeTime},
+			)
+			out, err := tablewriter.Render(opts.WithSortBy(tt.by, tablewriter.SortLexical), rows)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.HasSuffix(out, tt.want) {
+				t.Errorf("Render() = %q, want it to end with %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestColumnTypesJSON(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers: []string{"name", "count", "ratio", "ok"},
+		Format:  tablewriter.FormatJSON,
+	}.WithColumnTypes(tablewriter.TypeString, tablewriter.TypeInt, tablewriter.TypeFloat, tablewriter.TypeBool)
+	out, err := tablewriter.Render(opts, [][]string{{"web", "3", "0.5", "true"}, {"db", "", "x", "false"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	for _, want := range []string{`"count": 3`, `"ratio": 0.5`, `"ok": true`, `"count": null`, `"ratio": "x"`, `"name": "web"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Render() = %s, want it to contain %s", out, want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package tablewriter
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDuplicateKey is returned when a row repeats the value of the
+// Options.UniqueKey column.
+var ErrDuplicateKey = errors.New("tablewriter: duplicate key")
+
+// DuplicateKeyMode controls what AddRow does with a row whose UniqueKey value
+// is already in the table.
+type DuplicateKeyMode int
+
+const (
+	DuplicateKeyError     DuplicateKeyMode = iota // DuplicateKeyError rejects the row with ErrDuplicateKey (default).
+	DuplicateKeyOverwrite                         // DuplicateKeyOverwrite replaces the existing row in place.
+)
+
+// WithUniqueKey returns a copy of Options that enforces unique values in the
+// named column.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithUniqueKey("ID", tablewriter.DuplicateKeyError)
+func (o Options) WithUniqueKey(column string, mode DuplicateKeyMode) Options {
+	o.UniqueKey = column
+	o.OnDuplicateKey = mode
+	return o
+}
+
+// keyConflict returns the index of the row other than skip whose UniqueKey
+// cell equals that of cols, or -1 if there is none or no UniqueKey is set.
+// It looks the key up in t.keys, building the index on first use.
+func (t *Table) keyConflict(cols []string, skip int) (int, error) {
+	if t.opts.UniqueKey == "" {
+		return -1, nil
+	}
+	if t.keys == nil {
+		col := indexOf(resolveColumns(t.opts).Headers, t.opts.UniqueKey)
+		if col < 0 {
+			return -1, fmt.Errorf("%w: unique key %q", ErrUnknownColumn, t.opts.UniqueKey)
+		}
+		t.keyCol = col
+		t.keys = make(map[string]int, len(t.rows))
+		for i := len(t.rows) - 1; i >= 0; i-- {
+			t.keys[cellAt(t.rows[i], col)] = i
+		}
+	}
+	if i, ok := t.keys[cellAt(cols, t.keyCol)]; ok && i != skip {
+		return i, nil
+	}
+	return -1, nil
+}
+
+// indexKey records in t.keys that row i now holds cols, forgetting the key
+// of old, the row it replaces, if any.
+func (t *Table) indexKey(i int, old, cols []string) {
+	if t.keys == nil {
+		return
+	}
+	if old != nil {
+		if k := cellAt(old, t.keyCol); t.keys[k] == i {
+			delete(t.keys, k)
+		}
+	}
+	t.keys[cellAt(cols, t.keyCol)] = i
+}
+
+// duplicateKeyError describes a key that is already held by row i.
+func (t *Table) duplicateKeyError(cols []string, i int) error {
+	col := indexOf(resolveColumns(t.opts).Headers, t.opts.UniqueKey)
+	return fmt.Errorf("%w: %s %q is already used by row %d", ErrDuplicateKey, t.opts.UniqueKey, cellAt(cols, col), i)
+}
+
+// duplicateKeyNames maps each DuplicateKeyMode to its name in configuration files.
+var duplicateKeyNames = map[DuplicateKeyMode]string{
+	DuplicateKeyError:     "error",
+	DuplicateKeyOverwrite: "overwrite",
+}
+
+// MarshalText encodes m by name, e.g. "overwrite", implementing
+// encoding.TextMarshaler.
+func (m DuplicateKeyMode) MarshalText() ([]byte, error) {
+	return marshalEnum(m, duplicateKeyNames, "DuplicateKeyMode")
+}
+
+// UnmarshalText parses a name written by MarshalText, ignoring case,
+// implementing encoding.TextUnmarshaler.
+func (m *DuplicateKeyMode) UnmarshalText(text []byte) error {
+	v, err := unmarshalEnum(text, duplicateKeyNames, "DuplicateKeyMode")
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
@@ -0,0 +1,83 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestUniqueKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     tablewriter.DuplicateKeyMode
+		wantErr  error
+		wantRows [][]string
+	}{
+		{"error", tablewriter.DuplicateKeyError, tablewriter.ErrDuplicateKey, [][]string{{"1", "a"}, {"2", "b"}}},
+		{"overwrite", tablewriter.DuplicateKeyOverwrite, nil, [][]string{{"1", "c"}, {"2", "b"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Headers: []string{"ID", "Name"}}.WithUniqueKey("ID", tt.mode)
+			tbl := tablewriter.New(opts)
+			tbl.AddRow("1", "a")
+			tbl.AddRow("2", "b")
+			err := tbl.AddRow("1", "c")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddRow() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), `ID "1" is already used by row 0`) {
+				t.Errorf("AddRow() error = %q, want it to name the key and row", err)
+			}
+			if got := tbl.Rows(); !reflect.DeepEqual(got, tt.wantRows) {
+				t.Errorf("Rows() = %q, want %q", got, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestUniqueKeySetRow(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"ID"}, UniqueKey: "ID"})
+	tbl.AddRow("1")
+	tbl.AddRow("2")
+	if err := tbl.SetRow(1, "1"); !errors.Is(err, tablewriter.ErrDuplicateKey) {
+		t.Errorf("SetRow() error = %v, want %v", err, tablewriter.ErrDuplicateKey)
+	}
+	if err := tbl.SetRow(1, "2"); err != nil {
+		t.Errorf("SetRow() with its own key error = %v", err)
+	}
+}
+
+func TestUniqueKeyIndex(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"ID"}, UniqueKey: "ID"})
+	tbl.AddRows([][]string{{"1"}, {"2"}, {"3"}})
+	if err := tbl.SetRow(0, "4"); err != nil {
+		t.Fatalf("SetRow() error = %v", err)
+	}
+	if err := tbl.AddRow("1"); err != nil {
+		t.Errorf("AddRow() with a replaced key error = %v", err)
+	}
+	tbl.Reverse()
+	if err := tbl.AddRow("4"); err == nil || !strings.Contains(err.Error(), "row 3") {
+		t.Errorf("AddRow() after Reverse error = %v, want the duplicate at row 3", err)
+	}
+	tbl.Reset()
+	if err := tbl.AddRow("2"); err != nil {
+		t.Errorf("AddRow() after Reset error = %v", err)
+	}
+}
+
+func BenchmarkAddRowUniqueKey(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		tbl := tablewriter.New(tablewriter.Options{Headers: []string{"ID"}, UniqueKey: "ID"})
+		for j := 0; j < 10000; j++ {
+			if err := tbl.AddRow(strconv.Itoa(j)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package tablewriter
+
+import (
+	"context"
+	"strings"
+)
+
+// hasUnits reports whether any column has a unit.
+func hasUnits(opts Options) bool {
+	for _, u := range opts.Units {
+		if u != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// renderUnits renders the table with opts.Units in a row beneath the
+// headers. Headers are padded to the width of their unit so the renderer
+// sizes the columns for both. In Markdown, and in layouts that drop or
+// regroup columns, units are joined to the headers instead. Wrapped
+// headers are handled by renderWrappedHeaders.
+func renderUnits(ctx context.Context, opts Options, rows [][]string) (string, error) {
+	inner := opts
+	inner.Units = nil
+	inner.Headers = append([]string(nil), opts.Headers...)
+	units := make([]string, len(opts.Headers))
+	copy(units, opts.Units)
+
+	switch {
+	case opts.Format == FormatMarkdown:
+		for i, u := range units {
+			if u != "" {
+				inner.Headers[i] += "<br>" + u
+			}
+		}
+		return renderTable(ctx, inner, rows)
+	case opts.ResponsiveWidth > 0 || opts.SplitWidth > 0:
+		for i, u := range units {
+			if u != "" {
+				inner.Headers[i] += " (" + u + ")"
+			}
+		}
+		return renderTable(ctx, inner, rows)
+	}
+
+	for i, h := range inner.Headers {
+		hw, uw := measureWidth(h, opts), measureWidth(units[i], opts)
+		if uw > hw {
+			inner.Headers[i] = alignMeasured(h, hw, uw, alignAt(opts.Alignments, i))
+		}
+	}
+	out, err := renderTable(ctx, inner, rows)
+	if err != nil {
+		return "", err
+	}
+	widths, err := colWidths(ctx, inner, rows)
+	if err != nil {
+		return "", err
+	}
+	line := formatLine(opts.Format, units, widths, opts.Alignments, opts)
+	headerLine := 0
+	if opts.Format == FormatPlain {
+		headerLine = 1 // below the top border
+	}
+	lines := strings.Split(out, "\n")
+	if headerLine >= len(lines) {
+		return out, nil
+	}
+	lines = append(lines[:headerLine+1], append([]string{line}, lines[headerLine+1:]...)...)
+	return strings.Join(lines, "\n"), nil
+}
+
+// WithUnits returns a copy of Options with a per-column unit, e.g. "ms",
+// shown beneath or after each header; "" leaves a column without one.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithHeaders("Host", "Latency").WithUnits("", "ms")
+func (o Options) WithUnits(units ...string) Options {
+	o.Units = units
+	return o
+}
@@ -0,0 +1,62 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestUnits(t *testing.T) {
+	tests := []struct {
+		name   string
+		format tablewriter.Format
+		wrap   bool
+		split  int
+		want   []string
+	}{
+		{"simple", tablewriter.FormatSimple, false, 0, []string{"Host", "p99", "ms", "Memory", "GiB/node"}},
+		{"markdown", tablewriter.FormatMarkdown, false, 0, []string{"| Host  | p99<br>ms | Memory<br>GiB/node |"}},
+		{"markdown wrapped", tablewriter.FormatMarkdown, true, 0, []string{"| Host  | p99<br>ms | Memory<br>GiB/node |"}},
+		{"split", tablewriter.FormatSimple, false, 200, []string{"p99 (ms)", "Memory (GiB/node)"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:     []string{"Host", "p99", "Memory"},
+				Units:       []string{"", "ms", "GiB/node"},
+				Format:      tt.format,
+				WrapHeaders: tt.wrap,
+				SplitWidth:  tt.split,
+			}
+			got, err := tablewriter.Render(opts, [][]string{{"web-1", "12", "3.5"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("Render() = %q, missing %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestUnitsRowLayout(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers: []string{"p99", "Memory"},
+		Units:   []string{"ms", "GiB/node"},
+		Format:  tablewriter.FormatSimple,
+	}
+	got, err := tablewriter.Render(opts, [][]string{{"12", "3.5"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	lines := strings.Split(got, "\n")
+	if len(lines) < 3 || !strings.HasPrefix(lines[1], "ms") || !strings.Contains(lines[1], "GiB/node") {
+		t.Fatalf("Render() = %q, want units on the second line", got)
+	}
+	if strings.Index(lines[0], "Memory") != strings.Index(lines[1], "GiB/node") {
+		t.Errorf("Render() = %q, want units aligned with their headers", got)
+	}
+}
@@ -0,0 +1,29 @@
+package tablewriter
+
+import "fmt"
+
+// Upsert replaces the first row whose keyColumn cell equals the new row's,
+// or appends the row if there is none, so a periodically refreshed table
+// holds one row per key. keyColumn is a header name.
+// Returns ErrUnknownColumn if no header is named keyColumn, ErrColumnMismatch
+// if the row has no cell for it (or StrictColumnCount is set and counts
+// differ). It reports whether an existing row was replaced.
+//
+// Example:
+//
+//	replaced, err := t.Upsert("Host", "web-1", "healthy", "12ms")
+func (t *Table) Upsert(keyColumn string, cols ...string) (bool, error) {
+	col := indexOf(resolveColumns(t.opts).Headers, keyColumn)
+	if col < 0 {
+		return false, fmt.Errorf("%w: %q", ErrUnknownColumn, keyColumn)
+	}
+	if col >= len(cols) {
+		return false, fmt.Errorf("%w: row has no %q cell", ErrColumnMismatch, keyColumn)
+	}
+	for i, r := range t.rows {
+		if col < len(r) && r[col] == cols[col] {
+			return true, t.SetRow(i, cols...)
+		}
+	}
+	return false, t.AddRow(cols...)
+}
@@ -0,0 +1,41 @@
+package tablewriter_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestUpsert(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Host", "Status"}})
+	steps := []struct {
+		row          []string
+		wantReplaced bool
+	}{
+		{[]string{"web-1", "starting"}, false},
+		{[]string{"web-2", "healthy"}, false},
+		{[]string{"web-1", "healthy"}, true},
+	}
+	for _, s := range steps {
+		replaced, err := tbl.Upsert("Host", s.row...)
+		if err != nil || replaced != s.wantReplaced {
+			t.Fatalf("Upsert(%q) = %v, %v, want %v, nil", s.row, replaced, err, s.wantReplaced)
+		}
+	}
+	want := [][]string{{"web-1", "healthy"}, {"web-2", "healthy"}}
+	if got := tbl.Rows(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Rows() = %q, want %q", got, want)
+	}
+}
+
+func TestUpsertErrors(t *testing.T) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"Host", "Status"}})
+	if _, err := tbl.Upsert("Name", "a", "b"); !errors.Is(err, tablewriter.ErrUnknownColumn) {
+		t.Errorf("Upsert() error = %v, want %v", err, tablewriter.ErrUnknownColumn)
+	}
+	if _, err := tbl.Upsert("Status", "a"); !errors.Is(err, tablewriter.ErrColumnMismatch) {
+		t.Errorf("Upsert() error = %v, want %v", err, tablewriter.ErrColumnMismatch)
+	}
+}
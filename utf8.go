@@ -0,0 +1,18 @@
+package tablewriter
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// sanitizeUTF8 replaces each run of invalid UTF-8 bytes in v with marker,
+// or with U+FFFD if marker is empty.
+func sanitizeUTF8(v, marker string) string {
+	if utf8.ValidString(v) {
+		return v
+	}
+	if marker == "" {
+		marker = string(utf8.RuneError)
+	}
+	return strings.ToValidUTF8(v, marker)
+}
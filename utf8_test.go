@@ -0,0 +1,37 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestSanitizeUTF8(t *testing.T) {
+	tests := []struct {
+		name    string
+		marker  string
+		cell    string
+		wantOut string
+	}{
+		{"valid", "", "café", "café\n"},
+		{"default marker", "", "caf\xe9", "caf�\n"},
+		{"custom marker", "?", "a\xff\xfeb", "a?b\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Format:            tablewriter.FormatCSV,
+				SanitizeUTF8:      true,
+				InvalidUTF8Marker: tt.marker,
+			}
+			out, err := tablewriter.Render(opts, [][]string{{tt.cell}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.Contains(out, tt.wantOut) {
+				t.Errorf("Render() = %q, want it to contain %q", out, tt.wantOut)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package tablewriter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrInvalidInterval is returned by Watch when the interval is not positive.
+var ErrInvalidInterval = errors.New("tablewriter: watch interval must be positive")
+
+// Watch calls fetch immediately and then every interval, redrawing the rows
+// in place on w, like the watch(1) command. It returns when ctx is done,
+// with ctx.Err(), or when fetch or rendering fails. An interval of zero or
+// less returns ErrInvalidInterval without calling fetch.
+//
+// Example:
+//
+//	err := tablewriter.Watch(ctx, os.Stdout, opts, 2*time.Second, func() ([][]string, error) {
+//	    return listJobs()
+//	})
+func Watch(ctx context.Context, w io.Writer, opts Options, interval time.Duration, fetch func() ([][]string, error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidInterval, interval)
+	}
+	lt := NewLiveTable(w, opts)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		rows, err := fetch()
+		if err != nil {
+			return err
+		}
+		if err := lt.SetRows(rows); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
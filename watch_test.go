@@ -0,0 +1,63 @@
+package tablewriter_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestWatch(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+	tests := []struct {
+		name    string
+		failAt  int
+		wantErr error
+	}{
+		{"cancelled", 0, context.Canceled},
+		{"fetch error", 2, errFetch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			var buf bytes.Buffer
+			calls := 0
+			fetch := func() ([][]string, error) {
+				calls++
+				if calls == tt.failAt {
+					return nil, errFetch
+				}
+				if calls == 3 {
+					cancel()
+				}
+				return [][]string{{"job", strings.Repeat("#", calls)}}, nil
+			}
+			opts := tablewriter.Options{Headers: []string{"Name", "Progress"}, Format: tablewriter.FormatCSV}
+			err := tablewriter.Watch(ctx, &buf, opts, time.Millisecond, fetch)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Watch() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.failAt == 0 && !strings.Contains(buf.String(), "job,###") {
+				t.Errorf("Watch() output = %q, want it to contain the last fetch", buf.String())
+			}
+		})
+	}
+}
+
+func TestWatchInvalidInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		fetch := func() ([][]string, error) {
+			t.Fatal("fetch called with invalid interval")
+			return nil, nil
+		}
+		err := tablewriter.Watch(context.Background(), &bytes.Buffer{}, tablewriter.Options{}, interval, fetch)
+		if !errors.Is(err, tablewriter.ErrInvalidInterval) {
+			t.Errorf("Watch(%v) error = %v, want %v", interval, err, tablewriter.ErrInvalidInterval)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package tablewriter
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// measureWidth returns the layout width of s, using opts.WidthFunc if set.
+func measureWidth(s string, opts Options) int {
+	if opts.WidthFunc != nil {
+		return opts.WidthFunc(s)
+	}
+	return displayWidth(s)
+}
+
+// displayWidth returns the number of terminal columns s occupies, counting
+// wide East Asian characters as 2 and combining marks as 0. ANSI SGR escape
+// sequences such as "\x1b[31m" take no space and are not counted.
+func displayWidth(s string) int {
+	n := 0
+	for i := 0; i < len(s); {
+		if end := ansiSeqEnd(s, i); end > i {
+			i = end
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		n += runeDisplayWidth(r)
+	}
+	return n
+}
+
+// ansiReset is the SGR sequence that clears all styling.
+const ansiReset = "\x1b[0m"
+
+// ansiSeqEnd returns the index just past the ANSI CSI sequence starting at
+// s[i], or i if there is none.
+func ansiSeqEnd(s string, i int) int {
+	if i+1 >= len(s) || s[i] != '\x1b' || s[i+1] != '[' {
+		return i
+	}
+	for j := i + 2; j < len(s); j++ {
+		if c := s[j]; c >= 0x40 && c <= 0x7e {
+			return j + 1
+		}
+	}
+	return i
+}
+
+// TruncateUnit selects how width limits are measured when truncating.
+type TruncateUnit int
+
+const (
+	TruncateRunes        TruncateUnit = iota // TruncateRunes counts Unicode code points (default).
+	TruncateDisplayWidth                     // TruncateDisplayWidth counts terminal columns; wide East Asian characters count as 2.
+	TruncateBytes                            // TruncateBytes counts UTF-8 bytes, for byte-limited protocols.
+)
+
+// measure returns the function giving the size of one rune in unit u.
+func (u TruncateUnit) measure() func(rune) int {
+	switch u {
+	case TruncateDisplayWidth:
+		return runeDisplayWidth
+	case TruncateBytes:
+		return utf8.RuneLen
+	default:
+		return func(rune) int { return 1 }
+	}
+}
+
+// runeDisplayWidth returns the number of terminal columns r occupies:
+// 0 for combining marks and zero-width characters, 2 for wide East Asian
+// characters and emoji, and 1 otherwise.
+func runeDisplayWidth(r rune) int {
+	switch {
+	case r == 0x200B || r == 0x200C || r == 0x200D || r == 0xFEFF:
+		return 0
+	case unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r):
+		return 0
+	case r >= 0x1100 && r <= 0x115F, // Hangul Jamo
+		r >= 0x2E80 && r <= 0x303E, // CJK radicals, punctuation
+		r >= 0x3041 && r <= 0x33FF, // Kana, CJK symbols
+		r >= 0x3400 && r <= 0x4DBF, // CJK extension A
+		r >= 0x4E00 && r <= 0x9FFF, // CJK unified ideographs
+		r >= 0xA000 && r <= 0xA4CF, // Yi
+		r >= 0xAC00 && r <= 0xD7A3, // Hangul syllables
+		r >= 0xF900 && r <= 0xFAFF, // CJK compatibility ideographs
+		r >= 0xFE30 && r <= 0xFE4F, // CJK compatibility forms
+		r >= 0xFF00 && r <= 0xFF60, // Fullwidth forms
+		r >= 0xFFE0 && r <= 0xFFE6,
+		r >= 0x1F300 && r <= 0x1F64F, // Emoji
+		r >= 0x1F900 && r <= 0x1F9FF,
+		r >= 0x20000 && r <= 0x3FFFD: // CJK extensions B+
+		return 2
+	default:
+		return 1
+	}
+}
+
+// truncateUnitNames maps each TruncateUnit to its name in configuration files.
+var truncateUnitNames = map[TruncateUnit]string{
+	TruncateRunes:        "runes",
+	TruncateDisplayWidth: "display-width",
+	TruncateBytes:        "bytes",
+}
+
+// MarshalText encodes u by name, e.g. "display-width", implementing
+// encoding.TextMarshaler.
+func (u TruncateUnit) MarshalText() ([]byte, error) {
+	return marshalEnum(u, truncateUnitNames, "TruncateUnit")
+}
+
+// UnmarshalText parses a name written by MarshalText, ignoring case,
+// implementing encoding.TextUnmarshaler.
+func (u *TruncateUnit) UnmarshalText(text []byte) error {
+	v, err := unmarshalEnum(text, truncateUnitNames, "TruncateUnit")
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
@@ -0,0 +1,116 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestWidthFunc(t *testing.T) {
+	tests := []struct {
+		name      string
+		widthFunc func(string) int
+		wantNote  bool
+	}{
+		{"default", nil, false},
+		{"wide glyphs", func(s string) int { return 4 * utf8.RuneCountInString(s) }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:         []string{"Key", "Value"},
+				Format:          tablewriter.FormatMarkdown,
+				ResponsiveWidth: 30,
+				WidthFunc:       tt.widthFunc,
+			}
+			out, err := tablewriter.Render(opts, [][]string{{"a", "b"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got := strings.Contains(out, "column hidden"); got != tt.wantNote {
+				t.Errorf("Render() hid a column = %v, want %v:\n%s", got, tt.wantNote, out)
+			}
+		})
+	}
+}
+
+func TestDisplayWidthCJK(t *testing.T) {
+	tests := []struct {
+		name   string
+		format tablewriter.Format
+		want   string
+	}{
+		{
+			"plain",
+			tablewriter.FormatPlain,
+			"┌──────┬─────┐\n" +
+				"│ Name │ Qty │\n" +
+				"├──────┼─────┤\n" +
+				"│ 東京 │ 1   │\n" +
+				"│ abc  │ 22  │\n" +
+				"│ 日a  │ 333 │\n" +
+				"└──────┴─────┘\n",
+		},
+		{
+			"markdown",
+			tablewriter.FormatMarkdown,
+			"| Name | Qty |\n" +
+				"| ---- | --- |\n" +
+				"| 東京 | 1   |\n" +
+				"| abc  | 22  |\n" +
+				"| 日a  | 333 |\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{Headers: []string{"Name", "Qty"}, Format: tt.format}
+			out, err := tablewriter.Render(opts, [][]string{{"東京", "1"}, {"abc", "22"}, {"日a", "333"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() =\n%s\nwant\n%s", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaxColumnWidthCJK(t *testing.T) {
+	tests := []struct {
+		name string
+		opts tablewriter.Options
+		want string
+	}{
+		{
+			"runes",
+			tablewriter.Options{Headers: []string{"N"}, Format: tablewriter.FormatPlain, MaxColumnWidth: 6},
+			"┌───────────┐\n" +
+				"│ N         │\n" +
+				"├───────────┤\n" +
+				"│ 日本語... │\n" +
+				"└───────────┘\n",
+		},
+		{
+			"table width",
+			tablewriter.Options{Headers: []string{"N"}, Format: tablewriter.FormatPlain, MaxTableWidth: 10},
+			"┌───────┐\n" +
+				"│ N     │\n" +
+				"├───────┤\n" +
+				"│ 日... │\n" +
+				"└───────┘\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := tablewriter.Render(tt.opts, [][]string{{"日本語のテキスト"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out != tt.want {
+				t.Errorf("Render() =\n%s\nwant\n%s", out, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package tablewriter
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// maxWidthCacheEntries bounds a widthCache, which otherwise grows with every
+// format and entry point a table is rendered through.
+const maxWidthCacheEntries = 64
+
+// widthCache memoizes colWidths for a Table with CacheWidths set. Rather
+// than hashing every cell, entries are keyed by the table's version, which
+// reset bumps whenever rows or options change, and by where in a render
+// pipeline the widths were measured (see widthScope).
+type widthCache struct {
+	mu      sync.Mutex
+	version uint64
+	entries map[widthKey][]int
+}
+
+// widthKey identifies one colWidths call: the seq-th call made by a render
+// of the table at version through entry point use in format, with columns
+// the COLUMNS width the render read, if any.
+type widthKey struct {
+	version uint64
+	use     widthUse
+	format  Format
+	columns int
+	seq     int64
+}
+
+// widthUse names the entry point that started a render, since each makes a
+// different sequence of colWidths calls.
+type widthUse int
+
+const (
+	widthUseRender  widthUse = iota // widthUseRender is RenderErr, RenderAll, and Document.
+	widthUseColumns                 // widthUseColumns is ColumnWidths.
+)
+
+// widthScope attaches a widthCache to a single render. With the table's
+// rows and options fixed by the version, a render makes the same colWidths
+// calls with the same inputs every time, so the n-th call's widths are
+// cached under its sequence number.
+type widthScope struct {
+	cache *widthCache
+	key   widthKey
+	calls atomic.Int64
+}
+
+// widths returns the cached widths for the scope's next colWidths call,
+// computing and storing them with compute on a miss.
+func (s *widthScope) widths(compute func() []int) []int {
+	key := s.key
+	key.seq = s.calls.Add(1)
+	c := s.cache
+	c.mu.Lock()
+	w, ok := c.entries[key]
+	c.mu.Unlock()
+	if !ok {
+		w = compute()
+		c.mu.Lock()
+		if key.version == c.version {
+			if c.entries == nil || len(c.entries) >= maxWidthCacheEntries {
+				c.entries = make(map[widthKey][]int)
+			}
+			c.entries[key] = w
+		}
+		c.mu.Unlock()
+	}
+	return append([]int(nil), w...)
+}
+
+// reset bumps the version and drops every cached entry.
+func (c *widthCache) reset() {
+	c.mu.Lock()
+	c.version++
+	c.entries = nil
+	c.mu.Unlock()
+}
+
+// withWidthCache returns opts, which must be the table's own options with
+// at most Format changed, with a scope of the table's width cache attached
+// if opts.CacheWidths is set. Formatters may render the same value
+// differently from one render to the next, so tables with any are not
+// cached.
+func (t *Table) withWidthCache(opts Options, use widthUse) Options {
+	if !opts.CacheWidths || hasFormatters(opts) {
+		return opts
+	}
+	t.widths.mu.Lock()
+	version := t.widths.version
+	t.widths.mu.Unlock()
+	key := widthKey{version: version, use: use, format: opts.Format}
+	if len(opts.ColumnPercents) > 0 && opts.MaxTableWidth == 0 {
+		key.columns = terminalWidth()
+	}
+	opts.widthScope = &widthScope{cache: t.widths, key: key}
+	return opts
+}
+
+// hasFormatters reports whether opts sets a formatter for any column.
+func hasFormatters(opts Options) bool {
+	for _, f := range opts.Formatters {
+		if f != nil {
+			return true
+		}
+	}
+	for _, c := range opts.Columns {
+		if c.Formatter != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// WithCacheWidths returns a copy of Options that lets a Table remember the
+// column widths it computes between renders of unchanged rows.
+//
+// Example:
+//
+//	opts := tablewriter.DefaultOptions().WithCacheWidths()
+func (o Options) WithCacheWidths() Options {
+	o.CacheWidths = true
+	return o
+}
@@ -0,0 +1,112 @@
+package tablewriter_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestCacheWidths(t *testing.T) {
+	calls := 0
+	opts := tablewriter.Options{
+		Headers:     []string{"Name", "Size"},
+		CacheWidths: true,
+		WidthFunc: func(s string) int {
+			calls++
+			return utf8.RuneCountInString(s)
+		},
+	}
+	tbl := tablewriter.New(opts)
+	tbl.AddRow("readme.txt", "12")
+
+	first, _, err := tbl.ColumnWidths()
+	if err != nil {
+		t.Fatalf("ColumnWidths() error = %v", err)
+	}
+	if calls == 0 {
+		t.Fatal("ColumnWidths() did not measure cells")
+	}
+
+	calls = 0
+	again, _, _ := tbl.ColumnWidths()
+	if calls != 0 || !reflect.DeepEqual(again, first) {
+		t.Errorf("unchanged ColumnWidths() = %v with %d measurements, want %v with 0", again, calls, first)
+	}
+
+	tbl.AddRow("a-much-longer-name.txt", "3")
+	got, _, _ := tbl.ColumnWidths()
+	if calls == 0 || got[0] != 22 {
+		t.Errorf("ColumnWidths() after AddRow = %v with %d measurements, want width 22 remeasured", got, calls)
+	}
+}
+
+func TestCacheWidthsFormatters(t *testing.T) {
+	age := "1m ago"
+	tbl := tablewriter.New(tablewriter.Options{
+		Headers:     []string{"Seen"},
+		CacheWidths: true,
+		Formatters:  []tablewriter.Formatter{func(string) string { return age }},
+	})
+	tbl.AddRow("2024-01-01T00:00:00Z")
+	if _, _, err := tbl.ColumnWidths(); err != nil {
+		t.Fatalf("ColumnWidths() error = %v", err)
+	}
+	age = "12 days ago"
+	got, _, _ := tbl.ColumnWidths()
+	if want := len(age); got[0] != want {
+		t.Errorf("ColumnWidths() after the formatter changed = %v, want width %d", got, want)
+	}
+}
+
+func TestCacheWidthsMatchesUncached(t *testing.T) {
+	opts := tablewriter.Options{
+		Headers:       []string{"Name", "Note"},
+		Format:        tablewriter.FormatPlain,
+		MaxTableWidth: 24,
+		WrapHeaders:   true,
+	}
+	cached := opts
+	cached.CacheWidths = true
+	plain, tbl := tablewriter.New(opts), tablewriter.New(cached)
+	for _, r := range [][]string{{"alpha", "a fairly long note"}, {"b", "short"}} {
+		plain.AddRow(r...)
+		tbl.AddRow(r...)
+	}
+	for i := 0; i < 2; i++ {
+		if got, want := tbl.Render(), plain.Render(); got != want {
+			t.Errorf("render %d = %q, want %q", i, got, want)
+		}
+	}
+	plain.AddFooter("Total", "2")
+	tbl.AddFooter("Total", "2")
+	if got, want := tbl.Render(), plain.Render(); got != want {
+		t.Errorf("render after AddFooter = %q, want %q", got, want)
+	}
+	got, _, _ := tbl.ColumnWidths()
+	want, _, _ := plain.ColumnWidths()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ColumnWidths() = %v, want %v", got, want)
+	}
+	narrow := tbl.Options()
+	narrow.MaxTableWidth = 16
+	out, _ := tbl.RenderWith(narrow)
+	if want, _ := plain.RenderWith(narrow); out != want {
+		t.Errorf("RenderWith() = %q, want %q", out, want)
+	}
+}
+
+func BenchmarkCacheWidths(b *testing.B) {
+	tbl := tablewriter.New(tablewriter.Options{Headers: []string{"ID", "Name"}, CacheWidths: true})
+	for i := 0; i < 10000; i++ {
+		tbl.AddRow(strconv.Itoa(i), "name-"+strconv.Itoa(i))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := tbl.ColumnWidths(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
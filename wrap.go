@@ -0,0 +1,137 @@
+package tablewriter
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// wrapRows wraps every cell to opts.MaxColumnWidth. For FormatMarkdown the
+// wrapped lines are joined with "<br>"; for other formats each logical row
+// expands into as many physical rows as its tallest cell needs.
+func wrapRows(opts Options, rows [][]string) [][]string {
+	return wrapRowsTo(opts, rows, func(int) int { return opts.MaxColumnWidth })
+}
+
+// wrapRowsTo is like wrapRows but wraps each column to width(col).
+func wrapRowsTo(opts Options, rows [][]string, width func(col int) int) [][]string {
+	out := make([][]string, 0, len(rows))
+	for _, r := range rows {
+		cells := make([][]string, len(r))
+		height := 1
+		for i, c := range r {
+			if c == "" {
+				c = opts.NullPlaceholder
+			}
+			cells[i] = wrapText(c, width(i), opts.Hyphenate)
+			height = max(height, len(cells[i]))
+		}
+		if opts.Format == FormatMarkdown {
+			row := make([]string, len(r))
+			for i, lines := range cells {
+				row[i] = strings.Join(lines, "<br>")
+			}
+			out = append(out, row)
+			continue
+		}
+		for line := 0; line < height; line++ {
+			row := make([]string, len(r))
+			for i, lines := range cells {
+				if line < len(lines) {
+					row[i] = lines[line]
+				}
+			}
+			out = append(out, row)
+		}
+	}
+	return out
+}
+
+// wrapText breaks s into lines of at most width display columns. Existing
+// newlines are kept, lines break at spaces where possible, and words wider
+// than width are split, with a trailing "-" if hyphenate is set. ANSI escape
+// sequences take no space and are never split; styling still open at the
+// end of a line is reset there and reopened on the next.
+func wrapText(s string, width int, hyphenate bool) []string {
+	if width <= 0 {
+		return []string{s}
+	}
+	var lines []string
+	for _, para := range strings.Split(s, "\n") {
+		cur, curWidth := "", 0
+		for _, word := range strings.Fields(para) {
+			w := displayWidth(word)
+			if cur != "" && curWidth+1+w <= width {
+				cur, curWidth = cur+" "+word, curWidth+1+w
+				continue
+			}
+			if cur != "" {
+				lines = append(lines, cur)
+			}
+			for w > width {
+				n, dash := width, ""
+				if hyphenate && width > 1 {
+					n, dash = width-1, "-"
+				}
+				head, rest := splitWidth(word, n)
+				lines = append(lines, head+dash)
+				word, w = rest, displayWidth(rest)
+			}
+			cur, curWidth = word, w
+		}
+		lines = append(lines, cur)
+	}
+	if strings.Contains(s, "\x1b[") {
+		carryStyles(lines)
+	}
+	return lines
+}
+
+// splitWidth splits s after at most n display columns, keeping ANSI escape
+// sequences and zero-width runes with the text before them. At least one
+// visible rune is always taken, so a rune wider than n still makes progress.
+func splitWidth(s string, n int) (string, string) {
+	w := 0
+	for i := 0; i < len(s); {
+		if end := ansiSeqEnd(s, i); end > i {
+			i = end
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		rw := runeDisplayWidth(r)
+		if w > 0 && w+rw > n {
+			return s[:i], s[i:]
+		}
+		w += rw
+		i += size
+	}
+	return s, ""
+}
+
+// carryStyles ends each line that leaves ANSI SGR styling open with a reset
+// and reopens the styling at the start of the next line, so a wrapped styled
+// cell never colors the padding and borders between its lines.
+func carryStyles(lines []string) {
+	open := ""
+	for i, l := range lines {
+		l = open + l
+		open = ""
+		for j := 0; j < len(l); {
+			end := ansiSeqEnd(l, j)
+			if end == j {
+				j++
+				continue
+			}
+			switch seq := l[j:end]; {
+			case seq == ansiReset || seq == "\x1b[m":
+				open = ""
+			case strings.HasSuffix(seq, "m"):
+				open += seq
+			}
+			j = end
+		}
+		if open != "" {
+			l += ansiReset
+		}
+		lines[i] = l
+	}
+}
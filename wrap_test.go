@@ -0,0 +1,72 @@
+package tablewriter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/njchilds90/go-tablewriter"
+)
+
+func TestWrapCells(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    tablewriter.Format
+		hyphenate bool
+		cell      string
+		want      []string
+	}{
+		{"word boundaries", tablewriter.FormatSimple, false, "the quick brown fox", []string{"the quick\n", "brown fox\n"}},
+		{"long word hard break", tablewriter.FormatSimple, false, "abcdefghijklmn", []string{"abcdefghij\n", "klmn      \n"}},
+		{"long word hyphenated", tablewriter.FormatSimple, true, "abcdefghijklmn", []string{"abcdefghi-\n", "jklmn     \n"}},
+		{"markdown br", tablewriter.FormatMarkdown, false, "the quick brown fox", []string{"| the quick<br>brown fox |"}},
+		{"wide characters", tablewriter.FormatSimple, false, "日本語のテキスト", []string{"日本語のテ\n", "キスト    \n"}},
+		{"styled word", tablewriter.FormatSimple, false, "\x1b[31mabcdefghijklmn\x1b[0m", []string{"\x1b[31mabcdefghij\x1b[0m\n", "\x1b[31mklmn\x1b[0m      \n"}},
+		{"styled words", tablewriter.FormatSimple, false, "\x1b[1mthe quick brown\x1b[0m", []string{"\x1b[1mthe quick\x1b[0m\n", "\x1b[1mbrown\x1b[0m    \n"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Format:         tt.format,
+				MaxColumnWidth: 10,
+				WrapCells:      true,
+				Hyphenate:      tt.hyphenate,
+			}
+			out, err := tablewriter.Render(opts, [][]string{{tt.cell}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("Render() = %q, want it to contain %q", out, w)
+				}
+			}
+		})
+	}
+}
+
+func TestWrapHeaders(t *testing.T) {
+	tests := []struct {
+		name   string
+		format tablewriter.Format
+		want   string
+	}{
+		{"markdown", tablewriter.FormatMarkdown, "| Retry<br>Count | Name |"},
+		{"simple", tablewriter.FormatSimple, "Retry  Name\nCount"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tablewriter.Options{
+				Headers:     []string{"Retry Count", "Name"},
+				Format:      tt.format,
+				WrapHeaders: true,
+			}
+			out, err := tablewriter.Render(opts, [][]string{{"3", "api"}})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("Render() = %q, want it to contain %q", out, tt.want)
+			}
+		})
+	}
+}